@@ -1,14 +1,35 @@
 package inter
 
 import (
+	"github.com/golang/protobuf/proto"
+
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 )
 
+// Prefixes of EventsRoot merkle tree hashing.
+var (
+	merkleLeaf = []byte{0}
+	merkleNode = []byte{1}
+)
+
 // Block is a chain block.
 type Block struct {
-	Index  uint64
-	Events hash.EventsSlice
+	Index      uint64
+	Frame      uint64    // number of frame the block is made from
+	ParentHash hash.Hash // hash of the previous block, zero for the first one
+	StateRoot  hash.Hash // balances root of the block's frame
+	Time       Timestamp // consensus time of the block's Atropos
+	EventsRoot hash.Hash // merkle root of the events hashes
+	Events     hash.EventsSlice
+	// NextValidators is a hash of the next epoch validators,
+	// it is set by the first block of the previous epoch only.
+	NextValidators hash.Hash
+}
+
+// Hash returns hash of block header.
+func (b *Block) Hash() hash.Hash {
+	return BlockHashOf(b)
 }
 
 // ToWire converts to proto.Message.
@@ -16,32 +37,94 @@ func (b *Block) ToWire() *wire.Block {
 	if b == nil {
 		return nil
 	}
+	w := b.headerToWire()
+	w.Events = b.Events.ToWire()
+	w.Hash = b.Hash().Bytes()
+	return w
+}
+
+func (b *Block) headerToWire() *wire.Block {
 	return &wire.Block{
-		Index:  b.Index,
-		Events: b.Events.ToWire(),
+		Index:          b.Index,
+		Frame:          b.Frame,
+		ParentHash:     b.ParentHash.Bytes(),
+		StateRoot:      b.StateRoot.Bytes(),
+		Time:           uint64(b.Time),
+		EventsRoot:     b.EventsRoot.Bytes(),
+		NextValidators: b.NextValidators.Bytes(),
 	}
 }
 
 // WireToBlock converts from wire.
+// Block hash is not trusted, it is calculated again from the header.
 func WireToBlock(w *wire.Block) *Block {
 	if w == nil {
 		return nil
 	}
 	return &Block{
-		Index:  w.Index,
-		Events: hash.WireToEventHashSlice(w.Events),
+		Index:          w.Index,
+		Frame:          w.Frame,
+		ParentHash:     hash.FromBytes(w.ParentHash),
+		StateRoot:      hash.FromBytes(w.StateRoot),
+		Time:           Timestamp(w.Time),
+		EventsRoot:     hash.FromBytes(w.EventsRoot),
+		Events:         hash.WireToEventHashSlice(w.Events),
+		NextValidators: hash.FromBytes(w.NextValidators),
 	}
 }
 
 // NewBlock makes main chain block from topological ordered events.
-func NewBlock(index uint64, ordered Events) *Block {
+func NewBlock(index, frame uint64, time Timestamp, parent, stateRoot hash.Hash, ordered Events) *Block {
 	events := make(hash.EventsSlice, len(ordered))
 	for i, e := range ordered {
 		events[i] = e.Hash()
 	}
 
 	return &Block{
-		Index:  index,
-		Events: events,
+		Index:      index,
+		Frame:      frame,
+		ParentHash: parent,
+		StateRoot:  stateRoot,
+		Time:       time,
+		EventsRoot: EventsRootOf(events),
+		Events:     events,
+	}
+}
+
+// BlockHashOf calcs hash of block header.
+// Events are covered by EventsRoot.
+func BlockHashOf(b *Block) hash.Hash {
+	buf, err := proto.Marshal(b.headerToWire())
+	if err != nil {
+		log.Fatal(err)
 	}
+	return hash.Of(buf)
+}
+
+// EventsRootOf calcs merkle root of events hashes.
+// Leaves and nodes are hashed with different prefixes, so a node can't pass for
+// a leaf. Odd node of level is hashed alone.
+func EventsRootOf(events hash.EventsSlice) hash.Hash {
+	if len(events) == 0 {
+		return hash.Hash{}
+	}
+
+	level := make([]hash.Hash, len(events))
+	for i, e := range events {
+		level[i] = hash.Of(merkleLeaf, e.Bytes())
+	}
+
+	for len(level) > 1 {
+		next := make([]hash.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, hash.Of(merkleNode, level[i].Bytes()))
+				continue
+			}
+			next = append(next, hash.Of(merkleNode, level[i].Bytes(), level[i+1].Bytes()))
+		}
+		level = next
+	}
+
+	return level[0]
 }
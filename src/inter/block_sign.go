@@ -0,0 +1,188 @@
+package inter
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// BlockSign is a validator's signature of finalized block header.
+type BlockSign struct {
+	PubKey    *common.PublicKey
+	BlockN    uint64
+	BlockHash hash.Hash
+	Sign      string
+}
+
+// NewBlockSign signs block header by private key.
+func NewBlockSign(key *common.PrivateKey, b *Block) (*BlockSign, error) {
+	h := b.Hash()
+	R, S, err := key.Sign(h.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockSign{
+		PubKey:    key.Public(),
+		BlockN:    b.Index,
+		BlockHash: h,
+		Sign:      crypto.EncodeSignature(R, S),
+	}, nil
+}
+
+// Signer returns address of the validator.
+func (s *BlockSign) Signer() hash.Peer {
+	return hash.PeerOfPubkey(s.PubKey)
+}
+
+// Verify returns error if signature is invalid.
+func (s *BlockSign) Verify() error {
+	if s.PubKey == nil || s.PubKey.X == nil {
+		return fmt.Errorf("pubkey of block %d signer is invalid", s.BlockN)
+	}
+	r, ss, err := crypto.DecodeSignature(s.Sign)
+	if err != nil {
+		return err
+	}
+	if !s.PubKey.Verify(s.BlockHash.Bytes(), r, ss) {
+		signer := s.Signer()
+		return fmt.Errorf("block %d is not signed by %s", s.BlockN, signer.String())
+	}
+	return nil
+}
+
+// ToWire converts to proto.Message.
+func (s *BlockSign) ToWire() *wire.BlockSign {
+	if s == nil {
+		return nil
+	}
+	return &wire.BlockSign{
+		PubKey:    s.PubKey.Bytes(),
+		BlockN:    s.BlockN,
+		BlockHash: s.BlockHash.Bytes(),
+		Sign:      s.Sign,
+	}
+}
+
+// WireToBlockSign converts from wire.
+func WireToBlockSign(w *wire.BlockSign) *BlockSign {
+	if w == nil {
+		return nil
+	}
+	return &BlockSign{
+		PubKey:    common.BytesToPubkey(w.PubKey),
+		BlockN:    w.BlockN,
+		BlockHash: hash.FromBytes(w.BlockHash),
+		Sign:      w.Sign,
+	}
+}
+
+// BlockSignsToWire converts to wire.
+func BlockSignsToWire(ss []*BlockSign) []*wire.BlockSign {
+	if ss == nil {
+		return nil
+	}
+	res := make([]*wire.BlockSign, len(ss))
+	for i, s := range ss {
+		res[i] = s.ToWire()
+	}
+
+	return res
+}
+
+// WireToBlockSigns converts from wire.
+func WireToBlockSigns(ss []*wire.BlockSign) []*BlockSign {
+	if ss == nil {
+		return nil
+	}
+	res := make([]*BlockSign, len(ss))
+	for i, w := range ss {
+		res[i] = WireToBlockSign(w)
+	}
+
+	return res
+}
+
+/*
+ * BlockCertificate:
+ */
+
+// BlockCertificate is a proof of block finality:
+// signatures of validators with more than 2/3 of stake.
+type BlockCertificate struct {
+	Block *Block
+	Signs []*BlockSign
+	Epoch uint64 // epoch of the validators
+}
+
+// Stake returns sum of stakes of validators who have signed the block.
+// Invalid and duplicated signatures are not counted.
+func (c *BlockCertificate) Stake(validators map[hash.Peer]uint64) (stake uint64) {
+	if c.Block == nil {
+		return
+	}
+	h := c.Block.Hash()
+
+	signed := make(map[hash.Peer]struct{}, len(c.Signs))
+	for _, s := range c.Signs {
+		if s.BlockN != c.Block.Index || s.BlockHash != h || s.Verify() != nil {
+			continue
+		}
+		signer := s.Signer()
+		if _, ok := signed[signer]; ok {
+			continue
+		}
+		signed[signer] = struct{}{}
+		stake += validators[signer]
+	}
+	return
+}
+
+// Verify returns error if signers have no more than 2/3 of validators stake.
+func (c *BlockCertificate) Verify(validators map[hash.Peer]uint64) error {
+	if c.Block == nil {
+		return fmt.Errorf("certificate has no block")
+	}
+
+	var total uint64
+	for _, stake := range validators {
+		total += stake
+	}
+
+	stake := c.Stake(validators)
+	// stake*3 <= total*2 without overflow
+	x := new(big.Int).Mul(new(big.Int).SetUint64(stake), big.NewInt(3))
+	y := new(big.Int).Mul(new(big.Int).SetUint64(total), big.NewInt(2))
+	if x.Cmp(y) <= 0 {
+		return fmt.Errorf("block %d is signed by %d of %d stake only", c.Block.Index, stake, total)
+	}
+	return nil
+}
+
+// ToWire converts to proto.Message.
+func (c *BlockCertificate) ToWire() *wire.BlockCertificate {
+	if c == nil {
+		return nil
+	}
+	return &wire.BlockCertificate{
+		Block: c.Block.ToWire(),
+		Signs: BlockSignsToWire(c.Signs),
+		Epoch: c.Epoch,
+	}
+}
+
+// WireToBlockCertificate converts from wire.
+func WireToBlockCertificate(w *wire.BlockCertificate) *BlockCertificate {
+	if w == nil {
+		return nil
+	}
+	return &BlockCertificate{
+		Block: WireToBlock(w.Block),
+		Signs: WireToBlockSigns(w.Signs),
+		Epoch: w.Epoch,
+	}
+}
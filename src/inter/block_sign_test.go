@@ -0,0 +1,64 @@
+package inter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestBlockCertificate(t *testing.T) {
+	assertar := assert.New(t)
+
+	keys := make([]*common.PrivateKey, 4)
+	validators := make(map[hash.Peer]uint64, len(keys))
+	for i := range keys {
+		keys[i] = crypto.GenerateKey()
+		validators[hash.PeerOfPubkey(keys[i].Public())] = 1
+	}
+	// outsider
+	keys = append(keys, crypto.GenerateKey())
+
+	block := NewBlock(1, 1, Timestamp(1), hash.Hash{}, hash.FakeHash(), nil)
+	other := NewBlock(1, 1, Timestamp(2), hash.Hash{}, hash.FakeHash(), nil)
+
+	sign := func(i int, b *Block) *BlockSign {
+		s, err := NewBlockSign(keys[i], b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	s := sign(0, block)
+	assertar.NoError(s.Verify())
+	assertar.Equal(hash.PeerOfPubkey(keys[0].Public()), s.Signer())
+	assertar.Equal(s, WireToBlockSign(s.ToWire()))
+
+	forged := *s
+	forged.BlockHash = other.Hash()
+	assertar.Error(forged.Verify())
+
+	cert := &BlockCertificate{
+		Block: block,
+		Signs: []*BlockSign{
+			sign(0, block),
+			sign(0, block), // duplicate
+			sign(1, other), // another block
+			sign(4, block), // not a validator
+			sign(2, block),
+		},
+	}
+	assertar.Equal(uint64(2), cert.Stake(validators))
+	assertar.Error(cert.Verify(validators))
+
+	cert.Signs = append(cert.Signs, sign(3, block))
+	assertar.Equal(uint64(3), cert.Stake(validators))
+	assertar.NoError(cert.Verify(validators))
+
+	w := WireToBlockCertificate(cert.ToWire())
+	assertar.NoError(w.Verify(validators))
+}
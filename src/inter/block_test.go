@@ -0,0 +1,60 @@
+package inter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestBlock(t *testing.T) {
+	assertar := assert.New(t)
+
+	events := Events{
+		{Index: 1, Creator: hash.FakePeer(), Parents: hash.NewEvents(hash.ZeroEvent)},
+		{Index: 1, Creator: hash.FakePeer(), Parents: hash.NewEvents(hash.ZeroEvent)},
+		{Index: 1, Creator: hash.FakePeer(), Parents: hash.NewEvents(hash.ZeroEvent)},
+	}
+
+	b1 := NewBlock(1, 1, Timestamp(10), hash.Hash{}, hash.FakeHash(), events)
+	b2 := NewBlock(2, 2, Timestamp(20), b1.Hash(), hash.FakeHash(), events[:1])
+
+	assertar.Equal(b1.Hash(), b2.ParentHash)
+	assertar.NotEqual(b1.Hash(), b2.Hash())
+
+	w := WireToBlock(b1.ToWire())
+	assertar.Equal(b1, w)
+	assertar.Equal(b1.Hash(), w.Hash())
+
+	w.StateRoot = hash.FakeHash()
+	assertar.NotEqual(b1.Hash(), w.Hash())
+}
+
+func TestEventsRootOf(t *testing.T) {
+	assertar := assert.New(t)
+
+	ee := hash.FakeEvents(3).Slice()
+	leaf := func(e hash.Event) []byte {
+		return hash.Of([]byte{0}, e.Bytes()).Bytes()
+	}
+	node := func(hh ...[]byte) []byte {
+		return hash.Of(append([][]byte{{1}}, hh...)...).Bytes()
+	}
+
+	assertar.Equal(hash.Hash{}, EventsRootOf(nil))
+	assertar.Equal(leaf(ee[0]), EventsRootOf(ee[:1]).Bytes())
+	assertar.Equal(
+		node(leaf(ee[0]), leaf(ee[1])),
+		EventsRootOf(ee[:2]).Bytes())
+	assertar.Equal(
+		node(node(leaf(ee[0]), leaf(ee[1])), node(leaf(ee[2]))),
+		EventsRootOf(ee).Bytes())
+
+	// node can't pass for leaf
+	inner := hash.Event(hash.FromBytes(node(leaf(ee[0]), leaf(ee[1]))))
+	assertar.NotEqual(EventsRootOf(ee[:2]), EventsRootOf(hash.EventsSlice{inner}))
+
+	swapped := hash.EventsSlice{ee[1], ee[0], ee[2]}
+	assertar.NotEqual(EventsRootOf(ee), EventsRootOf(swapped))
+}
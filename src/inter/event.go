@@ -23,6 +23,8 @@ type Event struct {
 	LamportTime          Timestamp
 	InternalTransactions []*InternalTransaction
 	ExternalTransactions [][]byte
+	ForkProofs           []*ForkProof
+	BlockSigns           []*BlockSign
 	Sign                 string
 
 	hash hash.Event // cache for .Hash()
@@ -68,6 +70,18 @@ func (e *Event) Hash() hash.Event {
 	return e.hash
 }
 
+// Header returns event header.
+func (e *Event) Header() *EventHeader {
+	return &EventHeader{
+		Index:       e.Index,
+		Creator:     e.Creator,
+		Parents:     e.Parents,
+		LamportTime: e.LamportTime,
+		PayloadHash: PayloadHashOf(e),
+		Sign:        e.Sign,
+	}
+}
+
 // FindInternalTxn find transaction in event's internal transactions list.
 // TODO: use map
 func (e *Event) FindInternalTxn(idx hash.Transaction) *InternalTransaction {
@@ -84,6 +98,11 @@ func (e *Event) String() string {
 	return fmt.Sprintf("Event{%s, %s, t=%d}", e.Hash().String(), e.Parents.String(), e.LamportTime)
 }
 
+// Size returns size of event in wire format.
+func (e *Event) Size() int {
+	return proto.Size(e.ToWire())
+}
+
 // ToWire converts to proto.Message.
 func (e *Event) ToWire() *wire.Event {
 	if e == nil {
@@ -96,6 +115,8 @@ func (e *Event) ToWire() *wire.Event {
 		LamportTime:          uint64(e.LamportTime),
 		InternalTransactions: InternalTransactionsToWire(e.InternalTransactions),
 		ExternalTransactions: e.ExternalTransactions,
+		ForkProofs:           ForkProofsToWire(e.ForkProofs),
+		BlockSigns:           BlockSignsToWire(e.BlockSigns),
 		Sign:                 e.Sign,
 	}
 }
@@ -112,6 +133,8 @@ func WireToEvent(w *wire.Event) *Event {
 		LamportTime:          Timestamp(w.LamportTime),
 		InternalTransactions: WireToInternalTransactions(w.InternalTransactions),
 		ExternalTransactions: w.ExternalTransactions,
+		ForkProofs:           WireToForkProofs(w.ForkProofs),
+		BlockSigns:           WireToBlockSigns(w.BlockSigns),
 		Sign:                 w.Sign,
 	}
 }
@@ -121,14 +144,24 @@ func WireToEvent(w *wire.Event) *Event {
  */
 
 // EventHashOf calcs hash of event.
+// It is a hash of event header, so header is enough to check sign.
 func EventHashOf(e *Event) hash.Event {
-	w := e.ToWire()
-	w.Sign = ""
+	return e.Header().Hash()
+}
+
+// PayloadHashOf calcs hash of event txns, fork proofs and block signs.
+func PayloadHashOf(e *Event) hash.Hash {
+	w := &wire.Event{
+		InternalTransactions: InternalTransactionsToWire(e.InternalTransactions),
+		ExternalTransactions: e.ExternalTransactions,
+		ForkProofs:           ForkProofsToWire(e.ForkProofs),
+		BlockSigns:           BlockSignsToWire(e.BlockSigns),
+	}
 	buf, err := proto.Marshal(w)
 	if err != nil {
 		log.Fatal(err)
 	}
-	return hash.Event(hash.Of(buf))
+	return hash.Of(buf)
 }
 
 // FakeFuzzingEvents generates random independent events for test purpose.
@@ -0,0 +1,76 @@
+package inter
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// EventHeader is an event without payload but with hash of it.
+// It is enough to check event hash and sign.
+type EventHeader struct {
+	Index       uint64
+	Creator     hash.Peer
+	Parents     hash.Events
+	LamportTime Timestamp
+	PayloadHash hash.Hash
+	Sign        string
+}
+
+// Hash calcs hash of event the header of.
+func (h *EventHeader) Hash() hash.Event {
+	w := h.ToWire()
+	w.Sign = ""
+	buf, err := proto.Marshal(w)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return hash.Event(hash.Of(buf))
+}
+
+// Verify sign of header by public key.
+func (h *EventHeader) Verify(pubKey *common.PublicKey) bool {
+	if h.Sign == "" {
+		return false
+	}
+
+	r, s, err := crypto.DecodeSignature(h.Sign)
+	if err != nil {
+		return false
+	}
+
+	return pubKey.Verify(h.Hash().Bytes(), r, s)
+}
+
+// ToWire converts to proto.Message.
+func (h *EventHeader) ToWire() *wire.EventHeader {
+	if h == nil {
+		return nil
+	}
+	return &wire.EventHeader{
+		Index:       h.Index,
+		Creator:     h.Creator.Hex(),
+		Parents:     h.Parents.ToWire(),
+		LamportTime: uint64(h.LamportTime),
+		PayloadHash: h.PayloadHash.Bytes(),
+		Sign:        h.Sign,
+	}
+}
+
+// WireToEventHeader converts from wire.
+func WireToEventHeader(w *wire.EventHeader) *EventHeader {
+	if w == nil {
+		return nil
+	}
+	return &EventHeader{
+		Index:       w.Index,
+		Creator:     hash.HexToPeer(w.Creator),
+		Parents:     hash.WireToEventHashes(w.Parents),
+		LamportTime: Timestamp(w.LamportTime),
+		PayloadHash: hash.FromBytes(w.PayloadHash),
+		Sign:        w.Sign,
+	}
+}
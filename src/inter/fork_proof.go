@@ -0,0 +1,120 @@
+package inter
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// ForkProof is a proof of creator misbehaviour:
+// headers of two different signed events with the same index.
+type ForkProof struct {
+	PubKey *common.PublicKey
+	First  *EventHeader
+	Second *EventHeader
+}
+
+// NewForkProof makes proof from fork events headers.
+func NewForkProof(pub *common.PublicKey, h1, h2 *EventHeader) *ForkProof {
+	// canonical order to get the same proof from any node
+	if h2.Hash().Hex() < h1.Hash().Hex() {
+		h1, h2 = h2, h1
+	}
+	return &ForkProof{
+		PubKey: pub,
+		First:  h1,
+		Second: h2,
+	}
+}
+
+// Cheater returns address of the fork creator.
+func (p *ForkProof) Cheater() hash.Peer {
+	return p.First.Creator
+}
+
+// Hash calcs hash of proof, it is the same for any node (see NewForkProof).
+func (p *ForkProof) Hash() hash.Hash {
+	return hash.Of(p.First.Hash().Bytes(), p.Second.Hash().Bytes())
+}
+
+// Verify returns error if proof is invalid.
+func (p *ForkProof) Verify() error {
+	if p.First == nil || p.Second == nil {
+		return fmt.Errorf("fork proof is incomplete")
+	}
+	if p.First.Creator != p.Second.Creator || p.First.Index != p.Second.Index {
+		return fmt.Errorf("events %s and %s are not a fork", p.First.Hash().String(), p.Second.Hash().String())
+	}
+	if p.First.Hash() == p.Second.Hash() {
+		return fmt.Errorf("event %s is the same", p.First.Hash().String())
+	}
+	// the same fork has the only proof (see NewForkProof)
+	if p.Second.Hash().Hex() < p.First.Hash().Hex() {
+		return fmt.Errorf("events %s and %s are not in canonical order", p.First.Hash().String(), p.Second.Hash().String())
+	}
+	if p.PubKey == nil || p.PubKey.X == nil || hash.PeerOfPubkey(p.PubKey) != p.Cheater() {
+		return fmt.Errorf("pubkey of %s is invalid", p.First.Creator.String())
+	}
+	for _, h := range []*EventHeader{p.First, p.Second} {
+		if _, _, err := crypto.DecodeSignature(h.Sign); err != nil {
+			return err
+		}
+		if !h.Verify(p.PubKey) {
+			return fmt.Errorf("event %s is not signed by %s", h.Hash().String(), p.First.Creator.String())
+		}
+	}
+	return nil
+}
+
+// ToWire converts to proto.Message.
+func (p *ForkProof) ToWire() *wire.ForkProof {
+	if p == nil {
+		return nil
+	}
+	return &wire.ForkProof{
+		PubKey: p.PubKey.Bytes(),
+		First:  p.First.ToWire(),
+		Second: p.Second.ToWire(),
+	}
+}
+
+// WireToForkProof converts from wire.
+func WireToForkProof(w *wire.ForkProof) *ForkProof {
+	if w == nil {
+		return nil
+	}
+	return &ForkProof{
+		PubKey: common.BytesToPubkey(w.PubKey),
+		First:  WireToEventHeader(w.First),
+		Second: WireToEventHeader(w.Second),
+	}
+}
+
+// ForkProofsToWire converts to wire.
+func ForkProofsToWire(pp []*ForkProof) []*wire.ForkProof {
+	if pp == nil {
+		return nil
+	}
+	res := make([]*wire.ForkProof, len(pp))
+	for i, p := range pp {
+		res[i] = p.ToWire()
+	}
+
+	return res
+}
+
+// WireToForkProofs converts from wire.
+func WireToForkProofs(pp []*wire.ForkProof) []*ForkProof {
+	if pp == nil {
+		return nil
+	}
+	res := make([]*ForkProof, len(pp))
+	for i, w := range pp {
+		res[i] = WireToForkProof(w)
+	}
+
+	return res
+}
@@ -0,0 +1,67 @@
+package inter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestForkProof(t *testing.T) {
+	key := crypto.GenerateKey()
+	creator := hash.PeerOfPubkey(key.Public())
+
+	fork := func(n uint64) *EventHeader {
+		e := &Event{
+			Index:       1,
+			Creator:     creator,
+			Parents:     hash.NewEvents(hash.ZeroEvent),
+			LamportTime: Timestamp(n),
+		}
+		if err := e.SignBy(key); err != nil {
+			t.Fatal(err)
+		}
+		return e.Header()
+	}
+	e1, e2 := fork(1), fork(2)
+
+	t.Run("valid", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		proof := NewForkProof(key.Public(), e1, e2)
+		assertar.NoError(proof.Verify())
+		assertar.Equal(creator, proof.Cheater())
+
+		reverse := NewForkProof(key.Public(), e2, e1)
+		assertar.Equal(proof, reverse)
+
+		w := WireToForkProof(proof.ToWire())
+		assertar.NoError(w.Verify())
+	})
+
+	t.Run("the same event", func(t *testing.T) {
+		proof := NewForkProof(key.Public(), e1, e1)
+		assert.Error(t, proof.Verify())
+	})
+
+	t.Run("swapped", func(t *testing.T) {
+		proof := NewForkProof(key.Public(), e1, e2)
+		proof.First, proof.Second = proof.Second, proof.First
+		assert.Error(t, proof.Verify())
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		other := crypto.GenerateKey()
+		proof := NewForkProof(other.Public(), e1, e2)
+		assert.Error(t, proof.Verify())
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		e3 := fork(3)
+		e3.Sign = ""
+		proof := NewForkProof(key.Public(), e1, e3)
+		assert.Error(t, proof.Verify())
+	})
+}
@@ -15,12 +15,16 @@ type event struct {
 }
 
 // EventBuffer validates, bufferizes and drops() or processes() pushed() event
-// if all their parents exists().
+// if all their parents exists(). Events out of limits() are dropped at once.
+// Complete events are passed to fork() to detect forks (nil fork() skips check),
+// forks are processed anyway, so their descendants are complete on each node.
 // TODO: drop incomplete events by timeout.
 func EventBuffer(
+	limits func() Limits,
 	process func(*inter.Event),
 	drop func(*inter.Event, error),
-	exists func(hash.Event) *inter.Event) (
+	exists func(hash.Event) *inter.Event,
+	fork func(*inter.Event)) (
 	push func(*inter.Event)) {
 
 	var (
@@ -72,6 +76,9 @@ func EventBuffer(
 			drop(e.Event, err)
 			return
 		}
+		if fork != nil {
+			fork(e.Event)
+		}
 
 		// parents OK
 		process(e.Event)
@@ -92,6 +99,20 @@ func EventBuffer(
 			return
 		}
 
+		size := newSizeValidator(e, limits())
+		if err := size.CheckParents(); err != nil {
+			drop(e, err)
+			return
+		}
+		if err := size.CheckTxns(); err != nil {
+			drop(e, err)
+			return
+		}
+		if err := size.CheckBytes(); err != nil {
+			drop(e, err)
+			return
+		}
+
 		w := &event{
 			Event:   e,
 			parents: make(map[hash.Event]*inter.Event, len(e.Parents)),
@@ -3,6 +3,8 @@ package ordering
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
@@ -36,10 +38,58 @@ func TestEventBuffer(t *testing.T) {
 		t.Fatalf("%s unexpectedly dropped with %s", e.String(), err)
 	}
 
-	push := EventBuffer(process, drop, exists)
+	push := EventBuffer(func() Limits { return Limits{} }, process, drop, exists, nil)
 	for _, ee := range events {
 		for _, e := range ee {
 			push(e)
 		}
 	}
 }
+
+func TestEventBufferLimits(t *testing.T) {
+	assertar := assert.New(t)
+
+	var dropped error
+	push := EventBuffer(
+		func() Limits {
+			return Limits{
+				MaxBytes:   1024,
+				MaxTxns:    2,
+				MaxParents: 2,
+			}
+		},
+		func(e *inter.Event) {},
+		func(e *inter.Event, err error) {
+			dropped = err
+		},
+		func(e hash.Event) *inter.Event {
+			return nil
+		},
+		nil,
+	)
+
+	for name, e := range map[string]*inter.Event{
+		"parents": {
+			Parents: hash.FakeEvents(3),
+		},
+		"txns": {
+			Parents:              hash.FakeEvents(1),
+			ExternalTransactions: [][]byte{{1}, {2}, {3}},
+		},
+		"bytes": {
+			Parents:              hash.FakeEvents(1),
+			ExternalTransactions: [][]byte{make([]byte, 1024)},
+		},
+	} {
+		dropped = nil
+		push(e)
+		assertar.Error(dropped, name)
+	}
+
+	dropped = nil
+	push(&inter.Event{
+		Parents:              hash.FakeEvents(2),
+		ExternalTransactions: [][]byte{{1}, {2}},
+	})
+	assertar.NoError(dropped, "within limits")
+}
@@ -0,0 +1,60 @@
+package ordering
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+// Limits are max sizes of event. Zero value means unlimited.
+type Limits struct {
+	MaxBytes   int // max size of event in wire format
+	MaxTxns    int // max count of internal and external txns
+	MaxParents int // max count of parents (includes self-parent)
+}
+
+type sizeValidator struct {
+	event  *inter.Event
+	limits Limits
+}
+
+func newSizeValidator(e *inter.Event, limits Limits) *sizeValidator {
+	return &sizeValidator{
+		event:  e,
+		limits: limits,
+	}
+}
+
+func (v *sizeValidator) CheckParents() error {
+	if v.limits.MaxParents > 0 && len(v.event.Parents) > v.limits.MaxParents {
+		return fmt.Errorf("event %s has %d parents. Max is %d",
+			v.event.Hash().String(),
+			len(v.event.Parents),
+			v.limits.MaxParents)
+	}
+	return nil
+}
+
+func (v *sizeValidator) CheckTxns() error {
+	count := len(v.event.InternalTransactions) + len(v.event.ExternalTransactions)
+	if v.limits.MaxTxns > 0 && count > v.limits.MaxTxns {
+		return fmt.Errorf("event %s has %d txns. Max is %d",
+			v.event.Hash().String(),
+			count,
+			v.limits.MaxTxns)
+	}
+	return nil
+}
+
+func (v *sizeValidator) CheckBytes() error {
+	if v.limits.MaxBytes < 1 {
+		return nil
+	}
+	if size := v.event.Size(); size > v.limits.MaxBytes {
+		return fmt.Errorf("event %s has %d bytes. Max is %d",
+			v.event.Hash().String(),
+			size,
+			v.limits.MaxBytes)
+	}
+	return nil
+}
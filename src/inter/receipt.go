@@ -0,0 +1,62 @@
+package inter
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// TxnStatus is a result of internal transaction application.
+type TxnStatus uint32
+
+const (
+	// TxnApplied means txn changed the state.
+	TxnApplied TxnStatus = iota
+	// TxnSkipped means txn was ordered but did not change the state (see Receipt.Reason).
+	TxnSkipped
+)
+
+// String returns human readable status.
+func (s TxnStatus) String() string {
+	switch s {
+	case TxnApplied:
+		return "applied"
+	case TxnSkipped:
+		return "skipped"
+	default:
+		return "unknown"
+	}
+}
+
+// Receipt is a result of ordered internal transaction.
+type Receipt struct {
+	Status    TxnStatus
+	Reason    string
+	BlockN    uint64
+	StateRoot hash.Hash
+}
+
+// ToWire converts to proto.Message.
+func (r *Receipt) ToWire() *wire.Receipt {
+	if r == nil {
+		return nil
+	}
+	return &wire.Receipt{
+		Status:    uint32(r.Status),
+		Reason:    r.Reason,
+		BlockN:    r.BlockN,
+		StateRoot: r.StateRoot.Bytes(),
+	}
+}
+
+// WireToReceipt converts from wire.
+func WireToReceipt(w *wire.Receipt) *Receipt {
+	if w == nil {
+		return nil
+	}
+	return &Receipt{
+		Status:    TxnStatus(w.Status),
+		Reason:    w.Reason,
+		BlockN:    w.BlockN,
+		StateRoot: hash.FromBytes(w.StateRoot),
+	}
+}
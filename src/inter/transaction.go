@@ -6,12 +6,25 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 )
 
-// InternalTransaction is for stake transfer.
+// InternalTransactionKind is a kind of internal transaction.
+type InternalTransactionKind uint32
+
+const (
+	// StakeTransfer transfers or delegates Amount to Receiver.
+	StakeTransfer InternalTransactionKind = iota
+	// ValidatorRegistration makes sender a validator from the next epoch.
+	ValidatorRegistration
+	// ValidatorUnregistration makes sender an observer from the next epoch.
+	ValidatorUnregistration
+)
+
+// InternalTransaction is for stake transfer and validators registration.
 type InternalTransaction struct {
 	Index      uint64
 	Amount     uint64
 	Receiver   hash.Peer
 	UntilBlock uint64
+	Kind       InternalTransactionKind
 }
 
 // ToWire converts to wire.
@@ -24,6 +37,7 @@ func (tx *InternalTransaction) ToWire() *wire.InternalTransaction {
 		Amount:     tx.Amount,
 		Receiver:   tx.Receiver.Hex(),
 		UntilBlock: tx.UntilBlock,
+		Kind:       uint32(tx.Kind),
 	}
 }
 
@@ -37,6 +51,7 @@ func WireToInternalTransaction(w *wire.InternalTransaction) *InternalTransaction
 		Amount:     w.Amount,
 		Receiver:   hash.HexToPeer(w.Receiver),
 		UntilBlock: w.UntilBlock,
+		Kind:       InternalTransactionKind(w.Kind),
 	}
 }
 
@@ -75,3 +90,8 @@ func TransactionHashOf(sender hash.Peer, nonce uint64) hash.Transaction {
 	buf := append(sender.Bytes(), common.IntToBytes(nonce)...)
 	return hash.Transaction(hash.Of(buf))
 }
+
+// ExternalTransactionHashOf calcs hash of external transaction.
+func ExternalTransactionHashOf(payload []byte) hash.Transaction {
+	return hash.Transaction(hash.Of(payload))
+}
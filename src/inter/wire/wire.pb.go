@@ -25,6 +25,7 @@ type InternalTransaction struct {
 	Amount               uint64   `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
 	Receiver             string   `protobuf:"bytes,3,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
 	UntilBlock           uint64   `protobuf:"varint,4,opt,name=UntilBlock,proto3" json:"UntilBlock,omitempty"`
+	Kind                 uint32   `protobuf:"varint,5,opt,name=Kind,proto3" json:"Kind,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -83,6 +84,13 @@ func (m *InternalTransaction) GetUntilBlock() uint64 {
 	return 0
 }
 
+func (m *InternalTransaction) GetKind() uint32 {
+	if m != nil {
+		return m.Kind
+	}
+	return 0
+}
+
 type Event struct {
 	Index                uint64                 `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
 	Creator              string                 `protobuf:"bytes,2,opt,name=Creator,proto3" json:"Creator,omitempty"`
@@ -91,6 +99,8 @@ type Event struct {
 	InternalTransactions []*InternalTransaction `protobuf:"bytes,5,rep,name=InternalTransactions,proto3" json:"InternalTransactions,omitempty"`
 	ExternalTransactions [][]byte               `protobuf:"bytes,6,rep,name=ExternalTransactions,proto3" json:"ExternalTransactions,omitempty"`
 	Sign                 string                 `protobuf:"bytes,7,opt,name=Sign,proto3" json:"Sign,omitempty"`
+	ForkProofs           []*ForkProof           `protobuf:"bytes,8,rep,name=ForkProofs,proto3" json:"ForkProofs,omitempty"`
+	BlockSigns           []*BlockSign           `protobuf:"bytes,9,rep,name=BlockSigns,proto3" json:"BlockSigns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -170,9 +180,164 @@ func (m *Event) GetSign() string {
 	return ""
 }
 
+func (m *Event) GetForkProofs() []*ForkProof {
+	if m != nil {
+		return m.ForkProofs
+	}
+	return nil
+}
+
+func (m *Event) GetBlockSigns() []*BlockSign {
+	if m != nil {
+		return m.BlockSigns
+	}
+	return nil
+}
+
+type EventHeader struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=Creator,proto3" json:"Creator,omitempty"`
+	Parents              [][]byte `protobuf:"bytes,3,rep,name=Parents,proto3" json:"Parents,omitempty"`
+	LamportTime          uint64   `protobuf:"varint,4,opt,name=LamportTime,proto3" json:"LamportTime,omitempty"`
+	PayloadHash          []byte   `protobuf:"bytes,5,opt,name=PayloadHash,proto3" json:"PayloadHash,omitempty"`
+	Sign                 string   `protobuf:"bytes,6,opt,name=Sign,proto3" json:"Sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventHeader) Reset()         { *m = EventHeader{} }
+func (m *EventHeader) String() string { return proto.CompactTextString(m) }
+func (*EventHeader) ProtoMessage()    {}
+func (*EventHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2dcdddcdf68d8e0, []int{2}
+}
+
+func (m *EventHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventHeader.Unmarshal(m, b)
+}
+func (m *EventHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventHeader.Marshal(b, m, deterministic)
+}
+func (m *EventHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHeader.Merge(m, src)
+}
+func (m *EventHeader) XXX_Size() int {
+	return xxx_messageInfo_EventHeader.Size(m)
+}
+func (m *EventHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHeader proto.InternalMessageInfo
+
+func (m *EventHeader) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EventHeader) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventHeader) GetParents() [][]byte {
+	if m != nil {
+		return m.Parents
+	}
+	return nil
+}
+
+func (m *EventHeader) GetLamportTime() uint64 {
+	if m != nil {
+		return m.LamportTime
+	}
+	return 0
+}
+
+func (m *EventHeader) GetPayloadHash() []byte {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return nil
+}
+
+func (m *EventHeader) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+type ForkProof struct {
+	PubKey               []byte       `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	First                *EventHeader `protobuf:"bytes,2,opt,name=First,proto3" json:"First,omitempty"`
+	Second               *EventHeader `protobuf:"bytes,3,opt,name=Second,proto3" json:"Second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ForkProof) Reset()         { *m = ForkProof{} }
+func (m *ForkProof) String() string { return proto.CompactTextString(m) }
+func (*ForkProof) ProtoMessage()    {}
+func (*ForkProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2dcdddcdf68d8e0, []int{3}
+}
+
+func (m *ForkProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForkProof.Unmarshal(m, b)
+}
+func (m *ForkProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForkProof.Marshal(b, m, deterministic)
+}
+func (m *ForkProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkProof.Merge(m, src)
+}
+func (m *ForkProof) XXX_Size() int {
+	return xxx_messageInfo_ForkProof.Size(m)
+}
+func (m *ForkProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkProof proto.InternalMessageInfo
+
+func (m *ForkProof) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *ForkProof) GetFirst() *EventHeader {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *ForkProof) GetSecond() *EventHeader {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
 type Block struct {
 	Index                uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
 	Events               [][]byte `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
+	ParentHash           []byte   `protobuf:"bytes,3,opt,name=ParentHash,proto3" json:"ParentHash,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,4,opt,name=StateRoot,proto3" json:"StateRoot,omitempty"`
+	Time                 uint64   `protobuf:"varint,5,opt,name=Time,proto3" json:"Time,omitempty"`
+	EventsRoot           []byte   `protobuf:"bytes,6,opt,name=EventsRoot,proto3" json:"EventsRoot,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,7,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Frame                uint64   `protobuf:"varint,8,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	NextValidators       []byte   `protobuf:"bytes,9,opt,name=NextValidators,proto3" json:"NextValidators,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -182,7 +347,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f2dcdddcdf68d8e0, []int{2}
+	return fileDescriptor_f2dcdddcdf68d8e0, []int{4}
 }
 
 func (m *Block) XXX_Unmarshal(b []byte) error {
@@ -217,31 +382,286 @@ func (m *Block) GetEvents() [][]byte {
 	return nil
 }
 
+func (m *Block) GetParentHash() []byte {
+	if m != nil {
+		return m.ParentHash
+	}
+	return nil
+}
+
+func (m *Block) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *Block) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Block) GetEventsRoot() []byte {
+	if m != nil {
+		return m.EventsRoot
+	}
+	return nil
+}
+
+func (m *Block) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Block) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
+	}
+	return 0
+}
+
+func (m *Block) GetNextValidators() []byte {
+	if m != nil {
+		return m.NextValidators
+	}
+	return nil
+}
+
+type Receipt struct {
+	Status               uint32   `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	BlockN               uint64   `protobuf:"varint,3,opt,name=BlockN,proto3" json:"BlockN,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,4,opt,name=StateRoot,proto3" json:"StateRoot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2dcdddcdf68d8e0, []int{5}
+}
+
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Receipt.Unmarshal(m, b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return xxx_messageInfo_Receipt.Size(m)
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Receipt) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Receipt) GetBlockN() uint64 {
+	if m != nil {
+		return m.BlockN
+	}
+	return 0
+}
+
+func (m *Receipt) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+type BlockSign struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	BlockN               uint64   `protobuf:"varint,2,opt,name=BlockN,proto3" json:"BlockN,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,3,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Sign                 string   `protobuf:"bytes,4,opt,name=Sign,proto3" json:"Sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockSign) Reset()         { *m = BlockSign{} }
+func (m *BlockSign) String() string { return proto.CompactTextString(m) }
+func (*BlockSign) ProtoMessage()    {}
+func (*BlockSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2dcdddcdf68d8e0, []int{6}
+}
+
+func (m *BlockSign) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSign.Unmarshal(m, b)
+}
+func (m *BlockSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSign.Marshal(b, m, deterministic)
+}
+func (m *BlockSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSign.Merge(m, src)
+}
+func (m *BlockSign) XXX_Size() int {
+	return xxx_messageInfo_BlockSign.Size(m)
+}
+func (m *BlockSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSign proto.InternalMessageInfo
+
+func (m *BlockSign) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *BlockSign) GetBlockN() uint64 {
+	if m != nil {
+		return m.BlockN
+	}
+	return 0
+}
+
+func (m *BlockSign) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BlockSign) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+type BlockCertificate struct {
+	Block                *Block       `protobuf:"bytes,1,opt,name=Block,proto3" json:"Block,omitempty"`
+	Signs                []*BlockSign `protobuf:"bytes,2,rep,name=Signs,proto3" json:"Signs,omitempty"`
+	Epoch                uint64       `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BlockCertificate) Reset()         { *m = BlockCertificate{} }
+func (m *BlockCertificate) String() string { return proto.CompactTextString(m) }
+func (*BlockCertificate) ProtoMessage()    {}
+func (*BlockCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2dcdddcdf68d8e0, []int{7}
+}
+
+func (m *BlockCertificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockCertificate.Unmarshal(m, b)
+}
+func (m *BlockCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockCertificate.Marshal(b, m, deterministic)
+}
+func (m *BlockCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockCertificate.Merge(m, src)
+}
+func (m *BlockCertificate) XXX_Size() int {
+	return xxx_messageInfo_BlockCertificate.Size(m)
+}
+func (m *BlockCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockCertificate proto.InternalMessageInfo
+
+func (m *BlockCertificate) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockCertificate) GetSigns() []*BlockSign {
+	if m != nil {
+		return m.Signs
+	}
+	return nil
+}
+
+func (m *BlockCertificate) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*InternalTransaction)(nil), "wire.InternalTransaction")
 	proto.RegisterType((*Event)(nil), "wire.Event")
+	proto.RegisterType((*EventHeader)(nil), "wire.EventHeader")
+	proto.RegisterType((*ForkProof)(nil), "wire.ForkProof")
 	proto.RegisterType((*Block)(nil), "wire.Block")
+	proto.RegisterType((*Receipt)(nil), "wire.Receipt")
+	proto.RegisterType((*BlockSign)(nil), "wire.BlockSign")
+	proto.RegisterType((*BlockCertificate)(nil), "wire.BlockCertificate")
 }
 
 func init() { proto.RegisterFile("wire.proto", fileDescriptor_f2dcdddcdf68d8e0) }
 
 var fileDescriptor_f2dcdddcdf68d8e0 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0xc3, 0x40,
-	0x0c, 0xc6, 0xd5, 0xfc, 0x2b, 0x75, 0x99, 0x4c, 0x84, 0x0e, 0x06, 0x14, 0x65, 0xca, 0xd4, 0xa1,
-	0x88, 0x07, 0x00, 0xd4, 0xa1, 0x12, 0x48, 0xe8, 0x28, 0x0f, 0x70, 0x04, 0x0b, 0x9d, 0x48, 0x7c,
-	0xd5, 0xe5, 0x28, 0xdd, 0xd8, 0x79, 0x6a, 0x54, 0x93, 0xa2, 0x0e, 0xe9, 0xe6, 0xdf, 0xe7, 0xd8,
-	0x5f, 0xfc, 0x1d, 0xc0, 0x97, 0xf5, 0x34, 0x5b, 0x7b, 0x17, 0x1c, 0x26, 0xbb, 0xba, 0xfc, 0x86,
-	0xb3, 0x25, 0x07, 0xf2, 0x6c, 0x9a, 0x95, 0x37, 0xdc, 0x99, 0x3a, 0x58, 0xc7, 0x98, 0x43, 0xba,
-	0xe4, 0x37, 0xda, 0xaa, 0x51, 0x31, 0xaa, 0x12, 0xfd, 0x07, 0x78, 0x0e, 0xd9, 0x6d, 0xeb, 0x3e,
-	0x39, 0xa8, 0x48, 0xe4, 0x9e, 0xf0, 0x12, 0x4e, 0x34, 0xd5, 0x64, 0x37, 0xe4, 0x55, 0x5c, 0x8c,
-	0xaa, 0x89, 0xfe, 0x67, 0xbc, 0x02, 0x78, 0xe1, 0x60, 0x9b, 0xbb, 0xc6, 0xd5, 0x1f, 0x2a, 0x91,
-	0xb9, 0x03, 0xa5, 0xfc, 0x89, 0x20, 0x5d, 0x6c, 0x88, 0xc3, 0x11, 0x4f, 0x05, 0xe3, 0x7b, 0x4f,
-	0x26, 0x38, 0x2f, 0xa6, 0x13, 0xbd, 0xc7, 0x5d, 0xe7, 0xc9, 0x78, 0xe2, 0xd0, 0xa9, 0xb8, 0x88,
-	0xab, 0x53, 0xbd, 0x47, 0x2c, 0x60, 0xfa, 0x60, 0xda, 0xb5, 0xf3, 0x61, 0x65, 0x5b, 0xea, 0x4d,
-	0x0f, 0x25, 0x7c, 0x84, 0x7c, 0xe0, 0xec, 0x4e, 0xa5, 0x45, 0x5c, 0x4d, 0xe7, 0x17, 0x33, 0xc9,
-	0x69, 0xe0, 0x0b, 0x3d, 0x38, 0x86, 0x73, 0xc8, 0x17, 0xdb, 0x81, 0x75, 0x99, 0xfc, 0xd7, 0x60,
-	0x0f, 0x11, 0x92, 0x67, 0xfb, 0xce, 0x6a, 0x2c, 0x57, 0x49, 0x5d, 0xde, 0x40, 0x2a, 0xa9, 0x1c,
-	0xcf, 0x5f, 0xa2, 0xea, 0x54, 0x24, 0x8b, 0x7b, 0x7a, 0xcd, 0xe4, 0x45, 0xaf, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x90, 0x56, 0x8a, 0x3c, 0xdf, 0x01, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0x95, 0xe3, 0x9f, 0xc4, 0xe3, 0xf4, 0xfb, 0x60, 0x89, 0xd0, 0x82, 0x10, 0x32, 0x96, 0x80,
+	0x70, 0x53, 0xa4, 0xf0, 0x04, 0x50, 0x25, 0x6a, 0x55, 0xa8, 0xa2, 0x4d, 0xe1, 0x7e, 0x6b, 0x6f,
+	0xa9, 0xd5, 0x64, 0x37, 0x5a, 0x6f, 0xda, 0xf4, 0x39, 0x78, 0x10, 0x5e, 0x8c, 0x17, 0xe0, 0x0e,
+	0xed, 0xac, 0xeb, 0x58, 0x95, 0xc3, 0x25, 0x77, 0x73, 0xce, 0xfc, 0x78, 0xe6, 0xec, 0x8c, 0x01,
+	0x6e, 0x4b, 0x2d, 0x0e, 0xd7, 0x5a, 0x19, 0x45, 0x02, 0x6b, 0x67, 0x3f, 0x3c, 0x78, 0x72, 0x22,
+	0x8d, 0xd0, 0x92, 0x2f, 0xcf, 0x35, 0x97, 0x15, 0xcf, 0x4d, 0xa9, 0x24, 0x19, 0x41, 0x78, 0x22,
+	0x0b, 0xb1, 0xa5, 0x5e, 0xea, 0x8d, 0x03, 0xe6, 0x00, 0x79, 0x0a, 0xd1, 0xc7, 0x95, 0xda, 0x48,
+	0x43, 0x7b, 0x48, 0xd7, 0x88, 0x3c, 0x87, 0x01, 0x13, 0xb9, 0x28, 0x6f, 0x84, 0xa6, 0x7e, 0xea,
+	0x8d, 0x63, 0xd6, 0x60, 0xf2, 0x12, 0xe0, 0xab, 0x34, 0xe5, 0xf2, 0xd3, 0x52, 0xe5, 0xd7, 0x34,
+	0xc0, 0xbc, 0x16, 0x43, 0x08, 0x04, 0xa7, 0xa5, 0x2c, 0x68, 0x98, 0x7a, 0xe3, 0x03, 0x86, 0x76,
+	0xf6, 0xab, 0x07, 0xe1, 0xf4, 0x46, 0x48, 0xb3, 0xa7, 0x0f, 0x0a, 0xfd, 0x23, 0x2d, 0xb8, 0x51,
+	0x1a, 0x1b, 0x89, 0xd9, 0x3d, 0xb4, 0x9e, 0x39, 0xd7, 0x42, 0x9a, 0x8a, 0xfa, 0xa9, 0x3f, 0x1e,
+	0xb2, 0x7b, 0x48, 0x52, 0x48, 0x3e, 0xf3, 0xd5, 0x5a, 0x69, 0x73, 0x5e, 0xae, 0x44, 0xdd, 0x48,
+	0x9b, 0x22, 0x5f, 0x60, 0xd4, 0x21, 0x45, 0x45, 0xc3, 0xd4, 0x1f, 0x27, 0x93, 0x67, 0x87, 0x28,
+	0x5e, 0x47, 0x04, 0xeb, 0x4c, 0x23, 0x13, 0x18, 0x4d, 0xb7, 0x1d, 0xe5, 0x22, 0xec, 0xab, 0xd3,
+	0x67, 0xc5, 0x58, 0x94, 0xdf, 0x25, 0xed, 0xe3, 0x54, 0x68, 0x93, 0xf7, 0x00, 0x33, 0xa5, 0xaf,
+	0xe7, 0x5a, 0xa9, 0xcb, 0x8a, 0x0e, 0xb0, 0x99, 0xff, 0x5d, 0x33, 0x0d, 0xcf, 0x5a, 0x21, 0x36,
+	0x01, 0xa5, 0xb5, 0xd9, 0x15, 0x8d, 0xdb, 0x09, 0x0d, 0xcf, 0x5a, 0x21, 0xd9, 0x4f, 0x0f, 0x12,
+	0x94, 0xfb, 0x58, 0xf0, 0x42, 0xe8, 0x7f, 0x2a, 0x7a, 0x0a, 0xc9, 0x9c, 0xdf, 0x2d, 0x15, 0x2f,
+	0x8e, 0x79, 0x75, 0x85, 0x5b, 0x30, 0x64, 0x6d, 0xaa, 0xd1, 0x24, 0xda, 0x69, 0x92, 0xdd, 0x42,
+	0xdc, 0x0c, 0x6c, 0xb7, 0x72, 0xbe, 0xb9, 0x38, 0x15, 0x77, 0xd8, 0xef, 0x90, 0xd5, 0x88, 0xbc,
+	0x85, 0x70, 0x56, 0xea, 0xca, 0x2d, 0x6b, 0x32, 0x79, 0xec, 0x24, 0x68, 0x0d, 0xca, 0x9c, 0x9f,
+	0xbc, 0x83, 0x68, 0x21, 0x72, 0x25, 0x0b, 0xea, 0xef, 0x8b, 0xac, 0x03, 0xb2, 0xdf, 0x1e, 0x84,
+	0x6e, 0x6f, 0xf7, 0x5e, 0x08, 0xa6, 0x55, 0xb4, 0x87, 0x4a, 0xd4, 0xc8, 0x5e, 0x81, 0xd3, 0x04,
+	0xa7, 0xf4, 0xb1, 0xcf, 0x16, 0x43, 0x5e, 0x40, 0xbc, 0x30, 0xdc, 0x08, 0xa6, 0x94, 0x41, 0x99,
+	0x86, 0x6c, 0x47, 0x58, 0x09, 0x50, 0xbf, 0x10, 0x3f, 0x85, 0xb6, 0xad, 0xe8, 0x6a, 0x63, 0x4a,
+	0xe4, 0x2a, 0xee, 0x18, 0x9b, 0x83, 0xdf, 0xea, 0xa3, 0x07, 0x6d, 0xdb, 0xf3, 0x4c, 0xf3, 0x95,
+	0xa0, 0x03, 0xd7, 0x33, 0x02, 0xf2, 0x06, 0xfe, 0x3b, 0x13, 0x5b, 0xf3, 0x8d, 0x2f, 0xcb, 0xc2,
+	0xbe, 0xa7, 0xdd, 0x19, 0x9b, 0xf3, 0x80, 0xcd, 0x14, 0xf4, 0xf1, 0xaa, 0xd7, 0xc6, 0x8e, 0x69,
+	0xbb, 0xdb, 0x54, 0x38, 0xfd, 0x01, 0xab, 0x91, 0xe5, 0x99, 0xe0, 0x95, 0x92, 0xf5, 0x8a, 0xd4,
+	0xc8, 0xf2, 0xa8, 0xda, 0x19, 0x8e, 0x1e, 0xb0, 0x1a, 0xfd, 0x7d, 0xec, 0x6c, 0x05, 0x71, 0xb3,
+	0xa5, 0x7b, 0x5f, 0x79, 0x57, 0xba, 0xf7, 0xb0, 0x34, 0x5a, 0x2d, 0xc1, 0x77, 0x44, 0xb3, 0x54,
+	0x41, 0x6b, 0xa9, 0x34, 0x3c, 0xc2, 0x80, 0x23, 0xa1, 0x4d, 0x79, 0x59, 0xe6, 0xdc, 0x08, 0xf2,
+	0xaa, 0x7e, 0x6e, 0xfc, 0x68, 0x32, 0x49, 0x5a, 0x67, 0xc4, 0x9c, 0x87, 0xbc, 0x86, 0xd0, 0x5d,
+	0x5a, 0xaf, 0xfb, 0xd2, 0x9c, 0xd7, 0x6a, 0x3f, 0x5d, 0xab, 0xfc, 0xaa, 0x56, 0xc0, 0x81, 0x8b,
+	0x08, 0x7f, 0xc6, 0x1f, 0xfe, 0x0c, 0x00, 0xc7, 0x7c, 0x39, 0x6c, 0x9a, 0x05, 0x00, 0x00,
 }
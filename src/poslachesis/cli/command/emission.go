@@ -0,0 +1,40 @@
+package command
+
+import (
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+// Emission prints counts of emitted events by reasons.
+var Emission = &cobra.Command{
+	Use:   "emission",
+	Short: "Prints counts of emitted events by reasons",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		proxy, err := makeCtrlProxy(cmd)
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+
+		counts, err := proxy.GetEmissionStats()
+		if err != nil {
+			return err
+		}
+
+		reasons := make([]string, 0, len(counts))
+		for reason := range counts {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+
+		for _, reason := range reasons {
+			cmd.Printf("%s: %d\n", reason, counts[reason])
+		}
+		return nil
+	},
+}
+
+func init() {
+	initCtrlProxy(Emission)
+}
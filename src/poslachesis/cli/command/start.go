@@ -88,6 +88,32 @@ var Start = &cobra.Command{
 			return fmt.Errorf("unknown network name: %s", netName)
 		}
 
+		// pruning
+		conf.Prune, err = cmd.Flags().GetUint64("prune")
+		if err != nil {
+			return err
+		}
+		// events of the current epoch are retained anyway
+		if conf.Prune != 0 && conf.Prune < conf.Net.Rules.EpochLen {
+			return fmt.Errorf("prune %d is less than epoch length %d", conf.Prune, conf.Net.Rules.EpochLen)
+		}
+
+		// sync
+		conf.Node.FastSync, err = cmd.Flags().GetBool("fast-sync")
+		if err != nil {
+			return err
+		}
+		conf.Node.PushGossip, err = cmd.Flags().GetBool("push-gossip")
+		if err != nil {
+			return err
+		}
+
+		// transport
+		conf.Node.TLS, err = cmd.Flags().GetBool("tls")
+		if err != nil {
+			return err
+		}
+
 		// start
 		l := lachesis.New(db, "", key, conf)
 		l.Start()
@@ -115,6 +141,10 @@ func init() {
 	Start.Flags().String("log", "info", "log level")
 	Start.Flags().String("key", "", "private pem key path")
 	Start.Flags().String("dsn", "", "Sentry client DSN")
+	Start.Flags().Uint64("prune", 0, "keep events of the last N blocks only, N is not less than epoch length (0 keeps all)")
+	Start.Flags().Bool("fast-sync", false, "start from the last checkpoint of peers instead of genesis")
+	Start.Flags().Bool("push-gossip", false, "announce new events to peers")
+	Start.Flags().Bool("tls", false, "use TLS with certificates of node keys")
 }
 
 func readKey(path string) (*common.PrivateKey, error) {
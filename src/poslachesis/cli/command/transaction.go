@@ -18,7 +18,7 @@ var TxnInfo = &cobra.Command{
 		defer proxy.Close()
 
 		for _, hex := range args {
-			tx, event, block, err := proxy.GetTxnInfo(hash.HexToTransactionHash(hex))
+			tx, event, block, receipt, err := proxy.GetTxnInfo(hash.HexToTransactionHash(hex))
 			if err != nil {
 				return err
 			}
@@ -49,6 +49,14 @@ var TxnInfo = &cobra.Command{
 			cmd.Printf(" and is confirmed by block %d",
 				block.Index,
 			)
+
+			if receipt == nil {
+				return nil
+			}
+			cmd.Printf(", %s", receipt.Status)
+			if receipt.Reason != "" {
+				cmd.Printf(": %s", receipt.Reason)
+			}
 		}
 
 		return nil
@@ -24,6 +24,7 @@ func prepareApp() *cobra.Command {
 	app.AddCommand(command.Transfer)
 	app.AddCommand(command.TxnInfo)
 	app.AddCommand(command.LogLevel)
+	app.AddCommand(command.Emission)
 	app.AddCommand(command.Key)
 
 	return &app
@@ -161,6 +161,54 @@ func TestApp(t *testing.T) {
 		)
 	})
 
+	t.Run("txn skipped", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		h := hash.FakeTransaction()
+		txn := &inter.InternalTransaction{
+			Index:    1,
+			Amount:   rand.Uint64(),
+			Receiver: peer,
+		}
+		event := &inter.Event{
+			Index:   1,
+			Creator: hash.FakePeer(),
+			Parents: hash.Events{},
+		}
+		block := inter.NewBlock(1, 1, 0, hash.Hash{}, hash.Hash{}, inter.Events{event})
+		receipt := &inter.Receipt{
+			Status: inter.TxnSkipped,
+			Reason: "balance is insufficient",
+			BlockN: block.Index,
+		}
+
+		node.EXPECT().
+			GetInternalTxn(h).
+			Return(txn, event)
+		consensus.EXPECT().
+			GetEventBlock(event.Hash()).
+			Return(block)
+		consensus.EXPECT().
+			GetReceipt(h).
+			Return(receipt)
+
+		app.SetArgs([]string{
+			"txn",
+			h.Hex(),
+		})
+		defer out.Reset()
+
+		err := app.Execute()
+		if !assertar.NoError(err) {
+			return
+		}
+
+		assertar.Contains(
+			out.String(),
+			"confirmed by block 1, skipped: balance is insufficient",
+		)
+	})
+
 	t.Run("transfer missing flags", func(t *testing.T) {
 		assertar := assert.New(t)
 
@@ -239,6 +287,26 @@ func TestApp(t *testing.T) {
 		assertar.Contains(out.String(), "ok")
 	})
 
+	t.Run("emission ok", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		node.EXPECT().
+			EmissionStats().
+			Return(map[string]uint64{"emitted": 5, "txns": 3})
+
+		app.SetArgs([]string{
+			"emission",
+		})
+		defer out.Reset()
+
+		err := app.Execute()
+		if !assertar.NoError(err) {
+			return
+		}
+
+		assertar.Equal("emitted: 5\ntxns: 3\n", out.String())
+	})
+
 	t.Run("key ok", func(t *testing.T) {
 		assertar := assert.New(t)
 
@@ -5,24 +5,28 @@ import (
 	"strconv"
 
 	"github.com/Fantom-foundation/go-lachesis/src/posnode"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 )
 
 // Config of lachesis node.
 // TODO: move ports to Net?
 type Config struct {
-	Net      *Net
-	AppPort  int
-	CtrlPort int
-	Node     posnode.Config
+	Net       *Net
+	AppPort   int
+	CtrlPort  int
+	CacheSize int    // size of consensus store caches
+	Prune     uint64 // number of the last blocks which events are retained (not less than epoch length), 0 keeps all
+	Node      posnode.Config
 }
 
 // DefaultConfig returns lachesis default config.
 func DefaultConfig() *Config {
 	return &Config{
-		Net:      MainNet(),
-		AppPort:  55556,
-		CtrlPort: 55557,
-		Node:     *posnode.DefaultConfig(),
+		Net:       MainNet(),
+		AppPort:   55556,
+		CtrlPort:  55557,
+		CacheSize: posposet.DefaultCacheSize,
+		Node:      *posnode.DefaultConfig(),
 	}
 }
 
@@ -4,12 +4,15 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/common"
 	"github.com/Fantom-foundation/go-lachesis/src/crypto"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 )
 
 // Net describes lachesis net.
 type Net struct {
 	Name    string
 	Genesis map[hash.Peer]uint64
+	Economy posposet.Economy
+	Rules   posposet.ConsensusRules
 }
 
 // FakeNet generates fake net with n-nodes genesis.
@@ -25,6 +28,12 @@ func FakeNet(n int) (*Net, []*common.PrivateKey) {
 	return &Net{
 		Name:    "fake",
 		Genesis: genesis,
+		Economy: posposet.Economy{
+			InternalTxnFee:     1000,
+			ExternalTxnByteFee: 10,
+			BlockReward:        100000,
+		},
+		Rules: posposet.DefaultRules(),
 	}, keys
 }
 
@@ -35,6 +44,10 @@ func MainNet() *Net {
 		Genesis: map[hash.Peer]uint64{
 			// TODO: fill with official keys and balances.
 		},
+		Economy: posposet.Economy{
+			// TODO: fill with official fees and rewards.
+		},
+		Rules: posposet.DefaultRules(),
 	}
 }
 
@@ -45,5 +58,9 @@ func TestNet() *Net {
 		Genesis: map[hash.Peer]uint64{
 			// TODO: fill with official keys and balances.
 		},
+		Economy: posposet.Economy{
+			// TODO: fill with official fees and rewards.
+		},
+		Rules: posposet.DefaultRules(),
 	}
 }
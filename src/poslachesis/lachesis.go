@@ -33,13 +33,14 @@ func New(db *badger.DB, host string, key *common.PrivateKey, conf *Config, opts
 }
 
 func makeLachesis(db *badger.DB, host string, key *common.PrivateKey, conf *Config, listen network.ListenFunc, opts ...grpc.DialOption) *Lachesis {
-	ndb, cdb := makeStorages(db)
-
 	if conf == nil {
 		conf = DefaultConfig()
 	}
 
+	ndb, cdb := makeStorages(db, conf.CacheSize)
+
 	c := posposet.New(cdb, ndb)
+	c.PruneBlocks = conf.Prune
 	n := posnode.New(host, key, ndb, c, &conf.Node, listen, opts...)
 
 	return &Lachesis{
@@ -58,7 +59,9 @@ func makeLachesis(db *badger.DB, host string, key *common.PrivateKey, conf *Conf
 
 func (l *Lachesis) init() {
 	genesis := l.conf.Net.Genesis
-	err := l.consensusStore.ApplyGenesis(genesis)
+	economy := l.conf.Net.Economy
+	rules := l.conf.Net.Rules
+	err := l.consensusStore.ApplyGenesis(genesis, economy, rules)
 	if err != nil {
 		l.Fatal(err)
 	}
@@ -89,23 +92,21 @@ func (l *Lachesis) AddPeers(hosts ...string) {
  * Utils:
  */
 
-func makeStorages(db *badger.DB) (*posnode.Store, *posposet.Store) {
+func makeStorages(db *badger.DB, cacheSize int) (*posnode.Store, *posposet.Store) {
 	var (
-		p      kvdb.Database
-		n      kvdb.Database
-		cached bool
+		p kvdb.Database
+		n kvdb.Database
 	)
 	if db == nil {
 		p = kvdb.NewMemDatabase()
 		n = kvdb.NewMemDatabase()
-		cached = false
+		cacheSize = 0
 	} else {
 		db := kvdb.NewBadgerDatabase(db)
 		p = kvdb.NewTable(db, "p_")
 		n = kvdb.NewTable(db, "n_")
-		cached = true
 	}
 
 	return posnode.NewStore(n),
-		posposet.NewStore(p, cached)
+		posposet.NewStore(p, cacheSize)
 }
@@ -1,6 +1,8 @@
 package lachesis
 
 import (
+	"fmt"
+
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/network"
 	"github.com/Fantom-foundation/go-lachesis/src/poset"
@@ -51,12 +53,18 @@ func (l *Lachesis) serviceStart() {
 		for {
 			select {
 			case tx := <-app.SubmitCh():
-				l.node.AddExternalTxn(tx)
+				if err := l.node.AddExternalTxn(tx); err != nil {
+					l.Warn(err)
+				}
 			case tx := <-app.SubmitInternalCh():
 				l.node.AddInternalTxn(tx)
 			case num := <-l.consensus.NewBlockCh:
 				b := l.consensusStore.GetBlock(num)
-				block := l.toLegacyBlock(b)
+				block, err := l.toLegacyBlock(b)
+				if err != nil {
+					l.Error(err)
+					continue
+				}
 				_, _ = app.CommitBlock(*block)
 			case <-done:
 				return
@@ -73,18 +81,31 @@ func (l *Lachesis) serviceStop() {
 	l.service.done = nil
 }
 
-// TODO: it is temporary fake solution
-func (l *Lachesis) toLegacyBlock(b *inter.Block) *poset.Block {
+// toLegacyBlock converts block to the app proxy format.
+// Events could be pruned or be unknown after fast sync,
+// so error is returned instead of block with partial txns.
+func (l *Lachesis) toLegacyBlock(b *inter.Block) (*poset.Block, error) {
 	var txns [][]byte
 	for _, e := range b.Events {
 		event := l.nodeStore.GetEvent(e)
+		if event == nil {
+			return nil, fmt.Errorf("event %s of block %d is not found", e.String(), b.Index)
+		}
 		txns = append(txns, event.ExternalTransactions...)
 	}
-	// NOTE: Signatures and Hashes are empty
+
+	h := b.Hash()
+	// NOTE: Signatures are empty
 	return &poset.Block{
 		Body: &poset.BlockBody{
-			Index:        int64(b.Index),
-			Transactions: txns,
+			Index:         int64(b.Index),
+			RoundReceived: int64(b.Frame),
+			Transactions:  txns,
 		},
-	}
+		Hash:        h.Bytes(),
+		Hex:         h.Hex(),
+		StateHash:   b.StateRoot.Bytes(),
+		FrameHash:   b.EventsRoot.Bytes(),
+		CreatedTime: int64(b.Time),
+	}, nil
 }
@@ -3,6 +3,11 @@ package lachesis
 import (
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
 
 func TestService(t *testing.T) {
@@ -12,3 +17,29 @@ func TestService(t *testing.T) {
 
 	<-time.After(time.Second)
 }
+
+func TestToLegacyBlock(t *testing.T) {
+	assertar := assert.New(t)
+
+	l := NewForTests(nil, "server.fake", nil, nil)
+
+	e := &inter.Event{
+		Index:                1,
+		Creator:              hash.FakePeer(),
+		ExternalTransactions: [][]byte{[]byte("tx")},
+	}
+	l.nodeStore.SetEvent(e)
+
+	block, err := l.toLegacyBlock(inter.NewBlock(1, 1, 0, hash.Hash{}, hash.Hash{}, inter.Events{e}))
+	if assertar.NoError(err) {
+		assertar.Equal([][]byte{[]byte("tx")}, block.Body.Transactions)
+	}
+
+	// event is pruned
+	pruned := &inter.Event{
+		Index:   2,
+		Creator: e.Creator,
+	}
+	_, err = l.toLegacyBlock(inter.NewBlock(2, 2, 0, hash.Hash{}, hash.Hash{}, inter.Events{pruned}))
+	assertar.Error(err)
+}
@@ -0,0 +1,166 @@
+package posnode
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// maxPeerAddrs is a max count of addresses of each peer in the address book.
+const maxPeerAddrs = 8
+
+// AddPeerAddr puts host of peer into the address book.
+// Source is a peer which told about the host.
+func (n *Node) AddPeerAddr(id hash.Peer, host string, source hash.Peer) {
+	if id.IsEmpty() || host == "" {
+		return
+	}
+
+	n.peers.Lock()
+	defer n.peers.Unlock()
+
+	book := n.peerAddrs(id)
+	addr := addrOf(book, host)
+	if addr.Source == "" && !source.IsEmpty() {
+		addr.Source = source.Hex()
+	}
+	n.savePeerAddrs(id, book)
+}
+
+// PeerAddrs returns known hosts of peer, the best first.
+func (n *Node) PeerAddrs(id hash.Peer) []string {
+	n.peers.RLock()
+	defer n.peers.RUnlock()
+
+	book := n.peerAddrs(id)
+	res := make([]string, len(book.Addrs))
+	for i, addr := range book.Addrs {
+		res[i] = addr.Host
+	}
+	return res
+}
+
+// reachablePeerAddr returns the best host of peer seen after the time or empty string.
+func (n *Node) reachablePeerAddr(id hash.Peer, since time.Time) string {
+	n.peers.RLock()
+	defer n.peers.RUnlock()
+
+	for _, addr := range n.peerAddrs(id).Addrs {
+		if addr.LastSeen > since.UnixNano() {
+			return addr.Host
+		}
+	}
+	return ""
+}
+
+// markPeerAddr counts connection result of peer host.
+// It is not safe for concurrent use, so lock peers.
+func (n *Node) markPeerAddr(p *Peer, isSuccess bool) {
+	if p.ID.IsEmpty() || p.Host == "" {
+		return
+	}
+
+	book := n.peerAddrs(p.ID)
+	addr := addrOf(book, p.Host)
+	if isSuccess {
+		addr.LastSeen = time.Now().UnixNano()
+		addr.Fails = 0
+	} else {
+		addr.LastFail = time.Now().UnixNano()
+		addr.Fails++
+	}
+	n.savePeerAddrs(p.ID, book)
+}
+
+// bestPeerAddr returns the best known host of peer or empty string.
+// It is not safe for concurrent use, so lock peers.
+func (n *Node) bestPeerAddr(id hash.Peer) string {
+	book := n.peerAddrs(id)
+	if len(book.Addrs) < 1 {
+		return ""
+	}
+	return book.Addrs[0].Host
+}
+
+// randomPeerAddr returns random host of top peers to rejoin the network.
+func (n *Node) randomPeerAddr() string {
+	ids := n.peers.Snapshot()
+	if len(ids) < 1 {
+		return ""
+	}
+	id := ids[rand.Intn(len(ids))]
+
+	hosts := n.PeerAddrs(id)
+	if len(hosts) > 0 {
+		return hosts[rand.Intn(len(hosts))]
+	}
+
+	if peer := n.store.GetPeer(id); peer != nil {
+		return peer.Host
+	}
+	return ""
+}
+
+// peerAddrs returns address book of peer.
+func (n *Node) peerAddrs(id hash.Peer) *api.PeerAddrs {
+	book := n.store.GetPeerAddrs(id)
+	if book == nil {
+		book = &api.PeerAddrs{}
+	}
+	return book
+}
+
+// savePeerAddrs orders, trims and stores address book of peer.
+func (n *Node) savePeerAddrs(id hash.Peer, book *api.PeerAddrs) {
+	sort.Stable(addrsByQuality(book.Addrs))
+	if len(book.Addrs) > maxPeerAddrs {
+		book.Addrs = book.Addrs[:maxPeerAddrs]
+	}
+	n.store.SetPeerAddrs(id, book)
+}
+
+/*
+ * Utils:
+ */
+
+// addrOf returns address of book by host, adds new one if not found.
+func addrOf(book *api.PeerAddrs, host string) *api.PeerAddr {
+	for _, addr := range book.Addrs {
+		if addr.Host == host {
+			return addr
+		}
+	}
+
+	addr := &api.PeerAddr{
+		Host: host,
+	}
+	book.Addrs = append(book.Addrs, addr)
+	return addr
+}
+
+/*
+ * addrs sorting:
+ */
+
+// addrsByQuality is for sorting, the best first.
+type addrsByQuality []*api.PeerAddr
+
+// Len is the number of elements in the collection.
+func (aa addrsByQuality) Len() int {
+	return len(aa)
+}
+
+// Swap swaps the elements with indexes i and j.
+func (aa addrsByQuality) Swap(i, j int) { aa[i], aa[j] = aa[j], aa[i] }
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (aa addrsByQuality) Less(i, j int) bool {
+	if aa[i].Fails != aa[j].Fails {
+		return aa[i].Fails < aa[j].Fails
+	}
+	return aa[i].LastSeen > aa[j].LastSeen
+}
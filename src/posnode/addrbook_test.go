@@ -0,0 +1,81 @@
+package posnode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddrBook(t *testing.T) {
+	store := NewMemStore()
+	node := NewForTests("node", store, nil)
+	defer node.Stop()
+
+	peer := FakePeer("host0")
+	source := FakePeer("source")
+	store.BootstrapPeers(peer)
+	node.initPeers()
+
+	t.Run("add", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		node.AddPeerAddr(peer.ID, "host1", source.ID)
+		node.AddPeerAddr(peer.ID, "host1", peer.ID)
+		node.AddPeerAddr(peer.ID, "host2", peer.ID)
+
+		assertar.Equal([]string{"host1", "host2"}, node.PeerAddrs(peer.ID))
+		book := store.GetPeerAddrs(peer.ID)
+		assertar.Equal(source.ID.Hex(), book.Addrs[0].Source)
+	})
+
+	t.Run("fail and success", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		node.ConnectFail(&Peer{ID: peer.ID, Host: "host1"}, errors.New("test"))
+		assertar.Equal([]string{"host2", "host1"}, node.PeerAddrs(peer.ID))
+
+		node.ConnectOK(&Peer{ID: peer.ID, Host: "host1"})
+		assertar.Equal([]string{"host1", "host2"}, node.PeerAddrs(peer.ID))
+
+		book := store.GetPeerAddrs(peer.ID)
+		assertar.Equal(uint32(0), book.Addrs[0].Fails)
+		assertar.NotZero(book.Addrs[0].LastSeen)
+		assertar.NotZero(book.Addrs[0].LastFail)
+	})
+
+	t.Run("used for gossip", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		next := node.NextForGossip()
+		defer node.FreePeer(next)
+		if !assertar.NotNil(next) {
+			return
+		}
+		assertar.Equal("host1", next.Host)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		for i := 0; i < maxPeerAddrs*2; i++ {
+			node.AddPeerAddr(peer.ID, fmt.Sprintf("extra%d", i), source.ID)
+		}
+
+		hosts := node.PeerAddrs(peer.ID)
+		assertar.Len(hosts, maxPeerAddrs)
+		assertar.Equal("host1", hosts[0])
+	})
+
+	t.Run("persists", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		restarted := NewForTests("node", store, nil)
+		defer restarted.Stop()
+		restarted.initPeers()
+
+		assertar.Equal(node.PeerAddrs(peer.ID), restarted.PeerAddrs(peer.ID))
+		assertar.Contains(restarted.PeerAddrs(peer.ID), restarted.randomPeerAddr())
+	})
+}
@@ -0,0 +1,376 @@
+package api
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// maxIdlePeers is a size of per-peer (per-host) limits cache to start cleaning.
+const maxIdlePeers = 1024
+
+type (
+	// Limits are quotas of service resources. Zero value means unlimited.
+	Limits struct {
+		PeerRate       float64 // requests per second of each peer
+		TotalRate      float64 // requests per second of all the peers
+		PeerBandwidth  float64 // response bytes per second to each peer
+		TotalBandwidth float64 // response bytes per second to all the peers
+		MaxStreams     int     // max count of concurrent streams
+		HostRate       float64 // requests per second of each network host, checked before auth
+	}
+
+	// limiter enforces the limits.
+	limiter struct {
+		conf    Limits
+		total   *quota
+		peers   map[hash.Peer]*quota
+		streams int
+
+		sync.Mutex
+	}
+
+	// hostLimiter limits requests by network address,
+	// so it works before the peer is authenticated.
+	hostLimiter struct {
+		rate  float64
+		hosts map[string]*bucket
+
+		sync.Mutex
+	}
+
+	// quota is a rate and bandwidth buckets.
+	quota struct {
+		rate  *bucket
+		bytes *bucket
+	}
+
+	// bucket is a token bucket, nil is unlimited.
+	bucket struct {
+		rate   float64
+		burst  float64
+		tokens float64
+		last   time.Time
+	}
+)
+
+// ServerLimits makes server-side interceptors to enforce the limits.
+// Should be called after ServerAuth to identify peers.
+// Stream is charged when its request is received, so the peer is authenticated already.
+func ServerLimits(conf Limits) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	l := &limiter{
+		conf:  conf,
+		total: newQuota(conf.TotalRate, conf.TotalBandwidth),
+		peers: make(map[hash.Peer]*quota),
+	}
+
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		peer := GrpcPeerID(ctx)
+		if err := l.request(peer); err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		if m, ok := resp.(proto.Message); ok && err == nil {
+			l.sent(peer, proto.Size(m))
+		}
+
+		return resp, err
+	}
+
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		s := &limitedStream{
+			ServerStream: ss,
+			limiter:      l,
+		}
+		defer s.close()
+
+		return handler(srv, s)
+	}
+
+	return unary, stream
+}
+
+// ServerHostLimits makes server-side interceptors to limit request rate of each host.
+// Should be called before ServerAuth, so requests with forged identities
+// are throttled before their signs are checked.
+func ServerHostLimits(conf Limits) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	l := &hostLimiter{
+		rate:  conf.HostRate,
+		hosts: make(map[string]*bucket),
+	}
+
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.request(GrpcPeerHost(ctx)); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.request(GrpcPeerHost(ss.Context())); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+
+	return unary, stream
+}
+
+// limitedStream charges peer for the stream and counts sent bytes.
+type limitedStream struct {
+	grpc.ServerStream
+
+	peer    hash.Peer
+	opened  bool
+	limiter *limiter
+}
+
+// RecvMsg receives request and charges the authenticated peer for it.
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.opened {
+		return nil
+	}
+
+	peer := GrpcPeerID(s.Context())
+	if err := s.limiter.request(peer); err != nil {
+		return err
+	}
+	if err := s.limiter.openStream(); err != nil {
+		return err
+	}
+	s.peer, s.opened = peer, true
+
+	return nil
+}
+
+// SendMsg sends message if bandwidth is not exhausted.
+func (s *limitedStream) SendMsg(m interface{}) error {
+	if !s.opened {
+		return status.Error(codes.FailedPrecondition, "request should be received first")
+	}
+
+	if err := s.limiter.bandwidth(s.peer); err != nil {
+		return err
+	}
+
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	if msg, ok := m.(proto.Message); ok {
+		s.limiter.sent(s.peer, proto.Size(msg))
+	}
+	return nil
+}
+
+// close releases the stream if it was opened.
+func (s *limitedStream) close() {
+	if s.opened {
+		s.limiter.closeStream()
+	}
+}
+
+/*
+ * limiter's methods:
+ */
+
+// request takes request tokens of peer.
+func (l *limiter) request(peer hash.Peer) error {
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	q := l.peerQuota(peer)
+
+	if !l.total.bytes.ready(now) || !q.bytes.ready(now) {
+		return status.Error(codes.ResourceExhausted, "bandwidth limit exceeded")
+	}
+	if !l.total.rate.ready(now) || !q.rate.ready(now) {
+		return status.Error(codes.ResourceExhausted, "request rate limit exceeded")
+	}
+
+	l.total.rate.spend(1)
+	q.rate.spend(1)
+
+	return nil
+}
+
+// bandwidth checks bandwidth is not exhausted.
+func (l *limiter) bandwidth(peer hash.Peer) error {
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	q := l.peerQuota(peer)
+
+	if !l.total.bytes.ready(now) || !q.bytes.ready(now) {
+		return status.Error(codes.ResourceExhausted, "bandwidth limit exceeded")
+	}
+
+	return nil
+}
+
+// sent spends bandwidth tokens of peer.
+func (l *limiter) sent(peer hash.Peer, size int) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.total.bytes.spend(float64(size))
+	l.peerQuota(peer).bytes.spend(float64(size))
+}
+
+func (l *limiter) openStream() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.conf.MaxStreams > 0 && l.streams >= l.conf.MaxStreams {
+		return status.Error(codes.ResourceExhausted, "too many streams")
+	}
+	l.streams++
+
+	return nil
+}
+
+func (l *limiter) closeStream() {
+	l.Lock()
+	defer l.Unlock()
+
+	l.streams--
+}
+
+// peerQuota returns quota of peer.
+// It is not safe for concurrent use.
+func (l *limiter) peerQuota(peer hash.Peer) *quota {
+	q := l.peers[peer]
+	if q != nil {
+		return q
+	}
+
+	if len(l.peers) >= maxIdlePeers {
+		l.cleanIdle(time.Now())
+	}
+
+	q = newQuota(l.conf.PeerRate, l.conf.PeerBandwidth)
+	l.peers[peer] = q
+	return q
+}
+
+// cleanIdle forgets peers which quotas are full.
+func (l *limiter) cleanIdle(now time.Time) {
+	for peer, q := range l.peers {
+		if q.rate.full(now) && q.bytes.full(now) {
+			delete(l.peers, peer)
+		}
+	}
+}
+
+/*
+ * hostLimiter's methods:
+ */
+
+// request takes request token of host.
+func (l *hostLimiter) request(host string) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	b := l.hosts[host]
+	if b == nil {
+		if len(l.hosts) >= maxIdlePeers {
+			for h, b := range l.hosts {
+				if b.full(now) {
+					delete(l.hosts, h)
+				}
+			}
+		}
+		b = newBucket(l.rate)
+		l.hosts[host] = b
+	}
+
+	if !b.ready(now) {
+		return status.Error(codes.ResourceExhausted, "host request rate limit exceeded")
+	}
+	b.spend(1)
+
+	return nil
+}
+
+/*
+ * Utils:
+ */
+
+func newQuota(rate, bandwidth float64) *quota {
+	return &quota{
+		rate:  newBucket(rate),
+		bytes: newBucket(bandwidth),
+	}
+}
+
+// newBucket makes bucket with 1 second burst.
+func newBucket(rate float64) *bucket {
+	if rate <= 0 {
+		return nil
+	}
+	burst := math.Max(rate, 1)
+	return &bucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// ready refills bucket and returns true if it has tokens.
+func (b *bucket) ready(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+
+	// bucket could be made after now was taken
+	if now.After(b.last) {
+		b.tokens += b.rate * now.Sub(b.last).Seconds()
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	return b.tokens >= 1
+}
+
+// spend takes tokens, debt is allowed.
+func (b *bucket) spend(n float64) {
+	if b == nil {
+		return
+	}
+
+	b.tokens -= n
+}
+
+// full returns true if bucket is refilled completely.
+func (b *bucket) full(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+
+	b.ready(now)
+	return b.tokens >= b.burst
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Run("peer rate", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		l := &limiter{
+			conf:  Limits{PeerRate: 2},
+			total: newQuota(0, 0),
+			peers: make(map[hash.Peer]*quota),
+		}
+		a, b := hash.FakePeer(), hash.FakePeer()
+
+		assertar.NoError(l.request(a))
+		assertar.NoError(l.request(a))
+		assertar.Equal(codes.ResourceExhausted, status.Code(l.request(a)))
+		assertar.NoError(l.request(b), "other peer is not limited")
+
+		// refill
+		l.peers[a].rate.last = time.Now().Add(-time.Second)
+		assertar.NoError(l.request(a))
+	})
+
+	t.Run("total bandwidth", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		l := &limiter{
+			conf:  Limits{TotalBandwidth: 100},
+			total: newQuota(0, 100),
+			peers: make(map[hash.Peer]*quota),
+		}
+		a, b := hash.FakePeer(), hash.FakePeer()
+
+		assertar.NoError(l.request(a))
+		l.sent(a, 150)
+		assertar.Equal(codes.ResourceExhausted, status.Code(l.request(b)))
+		assertar.Equal(codes.ResourceExhausted, status.Code(l.bandwidth(a)))
+	})
+
+	t.Run("streams", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		l := &limiter{
+			conf:  Limits{MaxStreams: 1},
+			total: newQuota(0, 0),
+			peers: make(map[hash.Peer]*quota),
+		}
+
+		assertar.NoError(l.openStream())
+		assertar.Equal(codes.ResourceExhausted, status.Code(l.openStream()))
+		l.closeStream()
+		assertar.NoError(l.openStream())
+	})
+
+	t.Run("stream is charged after auth", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		l := &limiter{
+			conf:  Limits{PeerRate: 1, MaxStreams: 1},
+			total: newQuota(0, 0),
+			peers: make(map[hash.Peer]*quota),
+		}
+		a := hash.FakePeer()
+		ctx := context.WithValue(context.Background(), peerID{}, a)
+
+		forged := &limitedStream{
+			ServerStream: &fakeServerStream{ctx: ctx, recv: status.Error(codes.Unauthenticated, "forged")},
+			limiter:      l,
+		}
+		assertar.Error(forged.RecvMsg(nil))
+		assertar.Error(forged.SendMsg(nil))
+		forged.close()
+		assertar.Nil(l.peers[a], "forged peer is not charged")
+		assertar.Equal(0, l.streams)
+
+		s := &limitedStream{
+			ServerStream: &fakeServerStream{ctx: ctx},
+			limiter:      l,
+		}
+		assertar.NoError(s.RecvMsg(nil))
+		assertar.NotNil(l.peers[a])
+		assertar.Equal(1, l.streams)
+		s.close()
+		assertar.Equal(0, l.streams)
+	})
+
+	t.Run("host rate", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		l := &hostLimiter{
+			rate:  2,
+			hosts: make(map[string]*bucket),
+		}
+
+		assertar.NoError(l.request("a"))
+		assertar.NoError(l.request("a"))
+		assertar.Equal(codes.ResourceExhausted, status.Code(l.request("a")))
+		assertar.NoError(l.request("b"), "other host is not limited")
+	})
+}
+
+// fakeServerStream receives request with the result given.
+type fakeServerStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	recv error
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	return s.recv
+}
+
+func (s *fakeServerStream) SendMsg(m interface{}) error {
+	return nil
+}
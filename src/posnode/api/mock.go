@@ -7,6 +7,7 @@ package api
 import (
 	wire "github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	gomock "github.com/golang/mock/gomock"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	context "golang.org/x/net/context"
 	grpc "google.golang.org/grpc"
 	reflect "reflect"
@@ -95,6 +96,146 @@ func (mr *MockNodeClientMockRecorder) GetPeerInfo(ctx, in interface{}, opts ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerInfo", reflect.TypeOf((*MockNodeClient)(nil).GetPeerInfo), varargs...)
 }
 
+// GetCheckpoint mocks base method
+func (m *MockNodeClient) GetCheckpoint(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Checkpoint, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCheckpoint", varargs...)
+	ret0, _ := ret[0].(*Checkpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint
+func (mr *MockNodeClientMockRecorder) GetCheckpoint(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockNodeClient)(nil).GetCheckpoint), varargs...)
+}
+
+// GetStateNodes mocks base method
+func (m *MockNodeClient) GetStateNodes(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateNodes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStateNodes", varargs...)
+	ret0, _ := ret[0].(*StateNodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateNodes indicates an expected call of GetStateNodes
+func (mr *MockNodeClientMockRecorder) GetStateNodes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateNodes", reflect.TypeOf((*MockNodeClient)(nil).GetStateNodes), varargs...)
+}
+
+// StreamEvents mocks base method
+func (m *MockNodeClient) StreamEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Node_StreamEventsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamEvents", varargs...)
+	ret0, _ := ret[0].(Node_StreamEventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamEvents indicates an expected call of StreamEvents
+func (mr *MockNodeClientMockRecorder) StreamEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEvents", reflect.TypeOf((*MockNodeClient)(nil).StreamEvents), varargs...)
+}
+
+// AnnounceEvents mocks base method
+func (m *MockNodeClient) AnnounceEvents(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnounceEvents", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceEvents indicates an expected call of AnnounceEvents
+func (mr *MockNodeClientMockRecorder) AnnounceEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceEvents", reflect.TypeOf((*MockNodeClient)(nil).AnnounceEvents), varargs...)
+}
+
+// GetPeers mocks base method
+func (m *MockNodeClient) GetPeers(ctx context.Context, in *PeersRequest, opts ...grpc.CallOption) (*PeerList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPeers", varargs...)
+	ret0, _ := ret[0].(*PeerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeers indicates an expected call of GetPeers
+func (mr *MockNodeClientMockRecorder) GetPeers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeers", reflect.TypeOf((*MockNodeClient)(nil).GetPeers), varargs...)
+}
+
+// Handshake mocks base method
+func (m *MockNodeClient) Handshake(ctx context.Context, in *Hello, opts ...grpc.CallOption) (*Hello, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Handshake", varargs...)
+	ret0, _ := ret[0].(*Hello)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handshake indicates an expected call of Handshake
+func (mr *MockNodeClientMockRecorder) Handshake(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handshake", reflect.TypeOf((*MockNodeClient)(nil).Handshake), varargs...)
+}
+
+// SendTxns mocks base method
+func (m *MockNodeClient) SendTxns(ctx context.Context, in *TxnsBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendTxns", varargs...)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTxns indicates an expected call of SendTxns
+func (mr *MockNodeClientMockRecorder) SendTxns(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTxns", reflect.TypeOf((*MockNodeClient)(nil).SendTxns), varargs...)
+}
+
 // MockNodeServer is a mock of NodeServer interface
 type MockNodeServer struct {
 	ctrl     *gomock.Controller
@@ -162,3 +303,107 @@ func (mr *MockNodeServerMockRecorder) GetPeerInfo(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerInfo", reflect.TypeOf((*MockNodeServer)(nil).GetPeerInfo), arg0, arg1)
 }
+
+// GetCheckpoint mocks base method
+func (m *MockNodeServer) GetCheckpoint(arg0 context.Context, arg1 *empty.Empty) (*Checkpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(*Checkpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint
+func (mr *MockNodeServerMockRecorder) GetCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockNodeServer)(nil).GetCheckpoint), arg0, arg1)
+}
+
+// GetStateNodes mocks base method
+func (m *MockNodeServer) GetStateNodes(arg0 context.Context, arg1 *StateRequest) (*StateNodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStateNodes", arg0, arg1)
+	ret0, _ := ret[0].(*StateNodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateNodes indicates an expected call of GetStateNodes
+func (mr *MockNodeServerMockRecorder) GetStateNodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateNodes", reflect.TypeOf((*MockNodeServer)(nil).GetStateNodes), arg0, arg1)
+}
+
+// StreamEvents mocks base method
+func (m *MockNodeServer) StreamEvents(arg0 *EventsRequest, arg1 Node_StreamEventsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamEvents indicates an expected call of StreamEvents
+func (mr *MockNodeServerMockRecorder) StreamEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEvents", reflect.TypeOf((*MockNodeServer)(nil).StreamEvents), arg0, arg1)
+}
+
+// AnnounceEvents mocks base method
+func (m *MockNodeServer) AnnounceEvents(arg0 context.Context, arg1 *Announcement) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnounceEvents", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceEvents indicates an expected call of AnnounceEvents
+func (mr *MockNodeServerMockRecorder) AnnounceEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceEvents", reflect.TypeOf((*MockNodeServer)(nil).AnnounceEvents), arg0, arg1)
+}
+
+// GetPeers mocks base method
+func (m *MockNodeServer) GetPeers(arg0 context.Context, arg1 *PeersRequest) (*PeerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeers", arg0, arg1)
+	ret0, _ := ret[0].(*PeerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeers indicates an expected call of GetPeers
+func (mr *MockNodeServerMockRecorder) GetPeers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeers", reflect.TypeOf((*MockNodeServer)(nil).GetPeers), arg0, arg1)
+}
+
+// Handshake mocks base method
+func (m *MockNodeServer) Handshake(arg0 context.Context, arg1 *Hello) (*Hello, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handshake", arg0, arg1)
+	ret0, _ := ret[0].(*Hello)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handshake indicates an expected call of Handshake
+func (mr *MockNodeServerMockRecorder) Handshake(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handshake", reflect.TypeOf((*MockNodeServer)(nil).Handshake), arg0, arg1)
+}
+
+// SendTxns mocks base method
+func (m *MockNodeServer) SendTxns(arg0 context.Context, arg1 *TxnsBatch) (*empty.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTxns", arg0, arg1)
+	ret0, _ := ret[0].(*empty.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTxns indicates an expected call of SendTxns
+func (mr *MockNodeServerMockRecorder) SendTxns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTxns", reflect.TypeOf((*MockNodeServer)(nil).SendTxns), arg0, arg1)
+}
@@ -2,8 +2,14 @@ package api
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/golang/protobuf/proto"
@@ -21,6 +27,52 @@ import (
 // peerID is a internal key for context.Value().
 type peerID struct{}
 
+// serverID is a internal key for context.Value().
+type serverID struct{}
+
+const (
+	// authWindow is a max clock difference between client and server.
+	authWindow = time.Minute
+	// maxReplays is a max count of requests remembered to reject their replays.
+	maxReplays = 1 << 16
+	// maxClientReplays is a max count of remembered requests of the same client,
+	// so one client can not fill the cache to lock out the others.
+	maxClientReplays = 1 << 10
+)
+
+// anonymousMethods may be requested without server ID to discover unknown peers.
+var anonymousMethods = map[string]bool{
+	"/api.Node/GetPeerInfo": true,
+	"/api.Node/Handshake":   true,
+}
+
+// replayCache remembers signed requests until they expire.
+// If it is full, new requests are rejected: forgotten unexpired request could be replayed.
+type replayCache struct {
+	seen    map[hash.Hash]struct{}
+	clients map[hash.Hash]int
+	order   []replay // in adding order
+
+	sync.Mutex
+}
+
+// replay is a remembered request.
+type replay struct {
+	req    hash.Hash
+	client hash.Hash
+	expire time.Time
+}
+
+// WithServerID makes context for gRPC call to sign request for the server only.
+// Server-peer id is checked by its response sign too.
+func WithServerID(parent context.Context, id hash.Peer) context.Context {
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	return context.WithValue(parent, serverID{}, id)
+}
+
 // ClientAuth makes client-side interceptor for identification.
 func ClientAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryClientInterceptor {
 	pub := key.Public().Base64()
@@ -29,8 +81,9 @@ func ClientAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryClientInter
 	return func(ctx context.Context, method string, req interface{}, resp interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		// request:
 
-		servSign := signData(req, key, salt)
-		md := metadata.Pairs("sign", servSign, "pub", pub)
+		server, _ := ctx.Value(serverID{}).(hash.Peer)
+		md := signRequest(req, key, salt, method, server)
+		md.Set("pub", pub)
 		ctx = metadata.NewOutgoingContext(ctx, md)
 
 		var answer metadata.MD
@@ -42,12 +95,7 @@ func ClientAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryClientInter
 
 		// response:
 
-		servSign, servPub, err := readMetadata(answer)
-		if err != nil {
-			return status.Errorf(codes.Unauthenticated, err.Error())
-		}
-
-		err = verifyData(resp, servSign, servPub, salt)
+		servPub, err := verifyResponse(resp, answer, salt, md, server)
 		if err != nil {
 			return status.Errorf(codes.Unauthenticated, err.Error())
 		}
@@ -65,16 +113,18 @@ func ClientAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryClientInter
 func ServerAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryServerInterceptor {
 	pub := base64.StdEncoding.EncodeToString(key.Public().Bytes())
 	salt := genesis.Bytes()
+	self := hash.PeerOfPubkey(key.Public())
+	replays := newReplayCache()
 
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		// request:
 
-		clientSign, clientPub, err := parseContext(ctx)
-		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "data should be signed")
 		}
 
-		err = verifyData(req, clientSign, clientPub, salt)
+		clientSign, clientPub, err := verifyRequest(req, md, salt, info.FullMethod, self, replays)
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, err.Error())
 		}
@@ -85,9 +135,12 @@ func ServerAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryServerInter
 		// response:
 
 		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
 
-		sign := signData(resp, key, salt)
-		md := metadata.Pairs("sign", sign, "pub", pub)
+		sign := signData(resp, key, responseSalt(salt, clientSign))
+		md = metadata.Pairs("sign", sign, "pub", pub)
 		if err := grpc.SetTrailer(ctx, md); err != nil {
 			logger.Get().Fatal(err)
 		}
@@ -96,20 +149,161 @@ func ServerAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryServerInter
 	}
 }
 
-// parseContext reads fields from request/response context.
-func parseContext(ctx context.Context) (sign string, pub *common.PublicKey, err error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		err = errors.New("data should be signed")
-		return
+// ClientStreamAuth makes client-side stream interceptor for identification.
+// Request is signed as for unary call, so stream is opened with the first message.
+// Server signs the request back in header.
+func ClientStreamAuth(key *common.PrivateKey, genesis hash.Hash) grpc.StreamClientInterceptor {
+	pub := key.Public().Base64()
+	salt := genesis.Bytes()
+
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if desc.ClientStreams {
+			return nil, status.Errorf(codes.Unimplemented, "client stream is not supported")
+		}
+
+		server, _ := ctx.Value(serverID{}).(hash.Peer)
+		var md metadata.MD
+
+		return &clientAuthStream{
+			ctx: ctx,
+			open: func(req interface{}) (grpc.ClientStream, error) {
+				md = signRequest(req, key, salt, method, server)
+				md.Set("pub", pub)
+				return streamer(metadata.NewOutgoingContext(ctx, md), desc, cc, method, opts...)
+			},
+			verify: func(req interface{}, answer metadata.MD) error {
+				servPub, err := verifyResponse(req, answer, salt, md, server)
+				if err != nil {
+					return status.Errorf(codes.Unauthenticated, err.Error())
+				}
+
+				if set, ok := ctx.Value(peerID{}).(func(hash.Peer)); ok {
+					serverID := hash.PeerOfPubkey(servPub)
+					set(serverID)
+				}
+				return nil
+			},
+		}, nil
 	}
+}
 
-	sign, pub, err = readMetadata(md)
+// ServerStreamAuth makes server-side stream interceptor for identification.
+func ServerStreamAuth(key *common.PrivateKey, genesis hash.Hash) grpc.StreamServerInterceptor {
+	pub := base64.StdEncoding.EncodeToString(key.Public().Bytes())
+	salt := genesis.Bytes()
+	self := hash.PeerOfPubkey(key.Public())
+	replays := newReplayCache()
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			return status.Errorf(codes.Unimplemented, "client stream is not supported")
+		}
+
+		md, ok := metadata.FromIncomingContext(ss.Context())
+		if !ok {
+			return status.Errorf(codes.Unauthenticated, "data should be signed")
+		}
+		_, clientPub, err := readMetadata(md)
+		if err != nil {
+			return status.Errorf(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(srv, &serverAuthStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), peerID{}, hash.PeerOfPubkey(clientPub)),
+			verify: func(req interface{}) error {
+				clientSign, _, err := verifyRequest(req, md, salt, info.FullMethod, self, replays)
+				if err != nil {
+					return status.Errorf(codes.Unauthenticated, err.Error())
+				}
+
+				sign := signData(req, key, responseSalt(salt, clientSign))
+				md := metadata.Pairs("sign", sign, "pub", pub)
+				return ss.SendHeader(md)
+			},
+		})
+	}
+}
+
+// clientAuthStream opens stream on the first (signed) message.
+type clientAuthStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context
+	open   func(req interface{}) (grpc.ClientStream, error)
+	verify func(req interface{}, answer metadata.MD) error
+	req    interface{}
+}
+
+// Context returns the context for this stream.
+func (s *clientAuthStream) Context() context.Context {
+	if s.ClientStream == nil {
+		return s.ctx
+	}
+	return s.ClientStream.Context()
+}
+
+// SendMsg opens stream and sends request.
+func (s *clientAuthStream) SendMsg(m interface{}) (err error) {
+	if s.ClientStream != nil {
+		return status.Errorf(codes.Unimplemented, "client stream is not supported")
+	}
+
+	s.ClientStream, err = s.open(m)
 	if err != nil {
-		return
+		return err
 	}
+	s.req = m
 
-	return
+	return s.ClientStream.SendMsg(m)
+}
+
+// RecvMsg verifies server's sign before the first message.
+func (s *clientAuthStream) RecvMsg(m interface{}) error {
+	if s.verify != nil {
+		answer, err := s.ClientStream.Header()
+		if err == nil {
+			err = s.verify(s.req, answer)
+		}
+		if err != nil {
+			// server's error is preferred
+			if e := s.ClientStream.RecvMsg(m); e != nil && e != io.EOF {
+				return e
+			}
+			return err
+		}
+		s.verify = nil
+	}
+
+	return s.ClientStream.RecvMsg(m)
+}
+
+// serverAuthStream verifies the client's request.
+type serverAuthStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	verify func(req interface{}) error
+}
+
+// Context returns context with client's ID.
+func (s *serverAuthStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg receives and verifies request.
+func (s *serverAuthStream) RecvMsg(m interface{}) error {
+	if s.verify == nil {
+		return status.Errorf(codes.Unimplemented, "client stream is not supported")
+	}
+
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	err := s.verify(m)
+	s.verify = nil
+
+	return err
 }
 
 // readMetadata reads fields from metadata.
@@ -131,12 +325,117 @@ func readMetadata(md metadata.MD) (sign string, pub *common.PublicKey, err error
 	return
 }
 
+// signRequest signs request for the method of the server at the current time.
+// Empty server is allowed for anonymousMethods only.
+func signRequest(req interface{}, key *common.PrivateKey, salt []byte, method string, server hash.Peer) metadata.MD {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		logger.Get().Fatal(err)
+	}
+
+	md := metadata.Pairs(
+		"time", strconv.FormatInt(time.Now().UnixNano(), 10),
+		"nonce", hex.EncodeToString(nonce),
+		"to", "")
+	if !server.IsEmpty() {
+		md.Set("to", server.Hex())
+	}
+
+	d := requestDigest(req, salt, method, md)
+	R, S, _ := key.Sign(d.Bytes())
+	md.Set("sign", crypto.EncodeSignature(R, S))
+
+	return md
+}
+
+// verifyRequest checks request is signed for the method of the server
+// not long ago and is not a replay.
+func verifyRequest(req interface{}, md metadata.MD, salt []byte, method string, server hash.Peer, replays *replayCache) (
+	sign string, pub *common.PublicKey, err error) {
+
+	sign, pub, err = readMetadata(md)
+	if err != nil {
+		return
+	}
+
+	to := firstOf(md, "to")
+	if to == "" && !anonymousMethods[method] {
+		err = errors.New("request should be addressed to the server")
+		return
+	}
+	if to != "" && to != server.Hex() {
+		err = errors.New("request is addressed to another server")
+		return
+	}
+
+	nanos, err := strconv.ParseInt(firstOf(md, "time"), 10, 64)
+	if err != nil {
+		err = errors.New("request should be timestamped")
+		return
+	}
+	signed := time.Unix(0, nanos)
+	now := time.Now()
+	if signed.Before(now.Add(-authWindow)) || signed.After(now.Add(authWindow)) {
+		err = errors.New("request is expired or clock is out of sync")
+		return
+	}
+
+	d := requestDigest(req, salt, method, md)
+	if err = verifyDigest(d, sign, pub); err != nil {
+		return
+	}
+
+	if err = replays.add(hash.Of(pub.Bytes()), hash.Of(d.Bytes(), pub.Bytes()), signed.Add(authWindow), now); err != nil {
+		return
+	}
+
+	return
+}
+
+// verifyResponse checks response is signed back for the request by the server.
+func verifyResponse(resp interface{}, answer metadata.MD, salt []byte, req metadata.MD, server hash.Peer) (*common.PublicKey, error) {
+	servSign, servPub, err := readMetadata(answer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyData(resp, servSign, servPub, responseSalt(salt, firstOf(req, "sign")))
+	if err != nil {
+		return nil, err
+	}
+
+	if !server.IsEmpty() && server != hash.PeerOfPubkey(servPub) {
+		return nil, errors.New("response is signed by another server")
+	}
+
+	return servPub, nil
+}
+
+// requestDigest returns hash of request with its attributes.
+func requestDigest(req interface{}, salt []byte, method string, md metadata.MD) hash.Hash {
+	h := hashOfData(req)
+	return hash.Of(
+		h.Bytes(),
+		salt,
+		[]byte(method),
+		[]byte(firstOf(md, "time")),
+		[]byte(firstOf(md, "nonce")),
+		[]byte(firstOf(md, "to")))
+}
+
+// responseSalt binds response to the request.
+func responseSalt(salt []byte, reqSign string) []byte {
+	res := make([]byte, 0, len(salt)+len(reqSign))
+	res = append(res, salt...)
+	return append(res, reqSign...)
+}
+
 func signData(data interface{}, key *common.PrivateKey, salt []byte) string {
 	h := hashOfData(data)
 
-	d := append(h.Bytes(), salt...)
+	d := hash.Of(h.Bytes(), salt)
 
-	R, S, _ := key.Sign(d)
+	R, S, _ := key.Sign(d.Bytes())
 
 	return crypto.EncodeSignature(R, S)
 }
@@ -144,20 +443,33 @@ func signData(data interface{}, key *common.PrivateKey, salt []byte) string {
 func verifyData(data interface{}, sign string, pub *common.PublicKey, salt []byte) error {
 	h := hashOfData(data)
 
-	d := append(h.Bytes(), salt...)
+	d := hash.Of(h.Bytes(), salt)
 
+	return verifyDigest(d, sign, pub)
+}
+
+func verifyDigest(d hash.Hash, sign string, pub *common.PublicKey) error {
 	r, s, err := crypto.DecodeSignature(sign)
 	if err != nil {
 		return err
 	}
 
-	if !pub.Verify(d, r, s) {
+	if !pub.Verify(d.Bytes(), r, s) {
 		return errors.New("signature is invalid or peer uses another genesis")
 	}
 
 	return nil
 }
 
+// firstOf returns the first value of metadata key or empty string.
+func firstOf(md metadata.MD, key string) string {
+	vals := md.Get(key)
+	if len(vals) < 1 {
+		return ""
+	}
+	return vals[0]
+}
+
 func hashOfData(data interface{}) hash.Hash {
 	d, ok := data.(proto.Message)
 	if !ok {
@@ -184,3 +496,52 @@ func IsProtoEmpty(m *proto.Message) bool {
 	// return valToPointer(reflect.ValueOf(*m))
 	return m == nil || (*[2]unsafe.Pointer)(unsafe.Pointer(m))[1] == nil
 }
+
+/*
+ * replayCache's methods:
+ */
+
+func newReplayCache() *replayCache {
+	return &replayCache{
+		seen:    make(map[hash.Hash]struct{}),
+		clients: make(map[hash.Hash]int),
+	}
+}
+
+// add remembers request of client until expiration.
+// It returns error if request is seen already or if there is no room for it.
+func (c *replayCache) add(client, req hash.Hash, expire, now time.Time) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.seen[req]; ok {
+		return errors.New("request is replayed")
+	}
+
+	// forget the oldest requests if expired
+	for len(c.order) > 0 {
+		first := c.order[0]
+		if !first.expire.Before(now) {
+			break
+		}
+		delete(c.seen, first.req)
+		c.clients[first.client]--
+		if c.clients[first.client] < 1 {
+			delete(c.clients, first.client)
+		}
+		c.order[0] = replay{}
+		c.order = c.order[1:]
+	}
+
+	if c.clients[client] >= maxClientReplays {
+		return errors.New("too many requests of the client, try later")
+	}
+	if len(c.order) >= maxReplays {
+		return errors.New("too many requests, try later")
+	}
+
+	c.seen[req] = struct{}{}
+	c.clients[client]++
+	c.order = append(c.order, replay{req, client, expire})
+	return nil
+}
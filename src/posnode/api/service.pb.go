@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	wire "github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	context "golang.org/x/net/context"
 	grpc "google.golang.org/grpc"
 	math "math"
@@ -25,6 +26,7 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type KnownEvents struct {
 	Lasts                map[string]uint64 `protobuf:"bytes,1,rep,name=Lasts,proto3" json:"Lasts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LowestBlockN         uint64            `protobuf:"varint,2,opt,name=LowestBlockN,proto3" json:"LowestBlockN,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -62,6 +64,13 @@ func (m *KnownEvents) GetLasts() map[string]uint64 {
 	return nil
 }
 
+func (m *KnownEvents) GetLowestBlockN() uint64 {
+	if m != nil {
+		return m.LowestBlockN
+	}
+	return 0
+}
+
 type EventRequest struct {
 	PeerID               string   `protobuf:"bytes,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
 	Index                uint64   `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
@@ -160,6 +169,10 @@ type PeerInfo struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	PubKey               []byte   `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
 	Host                 string   `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	Seq                  uint64   `protobuf:"varint,4,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	Addrs                []string `protobuf:"bytes,5,rep,name=Addrs,proto3" json:"Addrs,omitempty"`
+	Versions             []uint32 `protobuf:"varint,6,rep,packed,name=Versions,proto3" json:"Versions,omitempty"`
+	Sign                 string   `protobuf:"bytes,7,opt,name=Sign,proto3" json:"Sign,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -211,41 +224,670 @@ func (m *PeerInfo) GetHost() string {
 	return ""
 }
 
+func (m *PeerInfo) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *PeerInfo) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *PeerInfo) GetVersions() []uint32 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *PeerInfo) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+type PeersRequest struct {
+	Max                  uint32   `protobuf:"varint,1,opt,name=Max,proto3" json:"Max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeersRequest) Reset()         { *m = PeersRequest{} }
+func (m *PeersRequest) String() string { return proto.CompactTextString(m) }
+func (*PeersRequest) ProtoMessage()    {}
+func (*PeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{4}
+}
+
+func (m *PeersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeersRequest.Unmarshal(m, b)
+}
+func (m *PeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeersRequest.Marshal(b, m, deterministic)
+}
+func (m *PeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeersRequest.Merge(m, src)
+}
+func (m *PeersRequest) XXX_Size() int {
+	return xxx_messageInfo_PeersRequest.Size(m)
+}
+func (m *PeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeersRequest proto.InternalMessageInfo
+
+func (m *PeersRequest) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type PeerList struct {
+	Peers []*PeerInfo `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`
+	// Hosts are addresses the peers were seen at, not signed.
+	Hosts                []string `protobuf:"bytes,2,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerList) Reset()         { *m = PeerList{} }
+func (m *PeerList) String() string { return proto.CompactTextString(m) }
+func (*PeerList) ProtoMessage()    {}
+func (*PeerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{5}
+}
+
+func (m *PeerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerList.Unmarshal(m, b)
+}
+func (m *PeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerList.Marshal(b, m, deterministic)
+}
+func (m *PeerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerList.Merge(m, src)
+}
+func (m *PeerList) XXX_Size() int {
+	return xxx_messageInfo_PeerList.Size(m)
+}
+func (m *PeerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerList proto.InternalMessageInfo
+
+func (m *PeerList) GetPeers() []*PeerInfo {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *PeerList) GetHosts() []string {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+type Hello struct {
+	Versions             []uint32 `protobuf:"varint,1,rep,packed,name=Versions,proto3" json:"Versions,omitempty"`
+	Capabilities         []string `protobuf:"bytes,2,rep,name=Capabilities,proto3" json:"Capabilities,omitempty"`
+	Genesis              []byte   `protobuf:"bytes,3,opt,name=Genesis,proto3" json:"Genesis,omitempty"`
+	Height               uint64   `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Hello) Reset()         { *m = Hello{} }
+func (m *Hello) String() string { return proto.CompactTextString(m) }
+func (*Hello) ProtoMessage()    {}
+func (*Hello) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{6}
+}
+
+func (m *Hello) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hello.Unmarshal(m, b)
+}
+func (m *Hello) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hello.Marshal(b, m, deterministic)
+}
+func (m *Hello) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hello.Merge(m, src)
+}
+func (m *Hello) XXX_Size() int {
+	return xxx_messageInfo_Hello.Size(m)
+}
+func (m *Hello) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hello.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hello proto.InternalMessageInfo
+
+func (m *Hello) GetVersions() []uint32 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *Hello) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *Hello) GetGenesis() []byte {
+	if m != nil {
+		return m.Genesis
+	}
+	return nil
+}
+
+func (m *Hello) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type Checkpoint struct {
+	Cert                 *wire.BlockCertificate `protobuf:"bytes,1,opt,name=Cert,proto3" json:"Cert,omitempty"`
+	Epochs               []*EpochProof          `protobuf:"bytes,2,rep,name=Epochs,proto3" json:"Epochs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{7}
+}
+
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Checkpoint.Unmarshal(m, b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return xxx_messageInfo_Checkpoint.Size(m)
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetCert() *wire.BlockCertificate {
+	if m != nil {
+		return m.Cert
+	}
+	return nil
+}
+
+func (m *Checkpoint) GetEpochs() []*EpochProof {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+type EpochProof struct {
+	Validators           map[string]uint64      `protobuf:"bytes,1,rep,name=Validators,proto3" json:"Validators,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Cert                 *wire.BlockCertificate `protobuf:"bytes,2,opt,name=Cert,proto3" json:"Cert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *EpochProof) Reset()         { *m = EpochProof{} }
+func (m *EpochProof) String() string { return proto.CompactTextString(m) }
+func (*EpochProof) ProtoMessage()    {}
+func (*EpochProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{8}
+}
+
+func (m *EpochProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochProof.Unmarshal(m, b)
+}
+func (m *EpochProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochProof.Marshal(b, m, deterministic)
+}
+func (m *EpochProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochProof.Merge(m, src)
+}
+func (m *EpochProof) XXX_Size() int {
+	return xxx_messageInfo_EpochProof.Size(m)
+}
+func (m *EpochProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochProof proto.InternalMessageInfo
+
+func (m *EpochProof) GetValidators() map[string]uint64 {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *EpochProof) GetCert() *wire.BlockCertificate {
+	if m != nil {
+		return m.Cert
+	}
+	return nil
+}
+
+type StateRequest struct {
+	Hashes               [][]byte `protobuf:"bytes,1,rep,name=Hashes,proto3" json:"Hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateRequest) Reset()         { *m = StateRequest{} }
+func (m *StateRequest) String() string { return proto.CompactTextString(m) }
+func (*StateRequest) ProtoMessage()    {}
+func (*StateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{9}
+}
+
+func (m *StateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateRequest.Unmarshal(m, b)
+}
+func (m *StateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateRequest.Marshal(b, m, deterministic)
+}
+func (m *StateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRequest.Merge(m, src)
+}
+func (m *StateRequest) XXX_Size() int {
+	return xxx_messageInfo_StateRequest.Size(m)
+}
+func (m *StateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateRequest proto.InternalMessageInfo
+
+func (m *StateRequest) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type StateNodes struct {
+	Nodes                [][]byte `protobuf:"bytes,1,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateNodes) Reset()         { *m = StateNodes{} }
+func (m *StateNodes) String() string { return proto.CompactTextString(m) }
+func (*StateNodes) ProtoMessage()    {}
+func (*StateNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{10}
+}
+
+func (m *StateNodes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateNodes.Unmarshal(m, b)
+}
+func (m *StateNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateNodes.Marshal(b, m, deterministic)
+}
+func (m *StateNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateNodes.Merge(m, src)
+}
+func (m *StateNodes) XXX_Size() int {
+	return xxx_messageInfo_StateNodes.Size(m)
+}
+func (m *StateNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateNodes proto.InternalMessageInfo
+
+func (m *StateNodes) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type Interval struct {
+	From                 uint64   `protobuf:"varint,1,opt,name=From,proto3" json:"From,omitempty"`
+	To                   uint64   `protobuf:"varint,2,opt,name=To,proto3" json:"To,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Interval) Reset()         { *m = Interval{} }
+func (m *Interval) String() string { return proto.CompactTextString(m) }
+func (*Interval) ProtoMessage()    {}
+func (*Interval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{11}
+}
+
+func (m *Interval) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Interval.Unmarshal(m, b)
+}
+func (m *Interval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Interval.Marshal(b, m, deterministic)
+}
+func (m *Interval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Interval.Merge(m, src)
+}
+func (m *Interval) XXX_Size() int {
+	return xxx_messageInfo_Interval.Size(m)
+}
+func (m *Interval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Interval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Interval proto.InternalMessageInfo
+
+func (m *Interval) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *Interval) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type EventsRequest struct {
+	Heights              map[string]*Interval `protobuf:"bytes,1,rep,name=Heights,proto3" json:"Heights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxBytes             uint64               `protobuf:"varint,2,opt,name=MaxBytes,proto3" json:"MaxBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{12}
+}
+
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsRequest.Unmarshal(m, b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+}
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return xxx_messageInfo_EventsRequest.Size(m)
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+func (m *EventsRequest) GetHeights() map[string]*Interval {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+type Announcement struct {
+	Hashes               [][]byte `protobuf:"bytes,1,rep,name=Hashes,proto3" json:"Hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Announcement) Reset()         { *m = Announcement{} }
+func (m *Announcement) String() string { return proto.CompactTextString(m) }
+func (*Announcement) ProtoMessage()    {}
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{13}
+}
+
+func (m *Announcement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Announcement.Unmarshal(m, b)
+}
+func (m *Announcement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Announcement.Marshal(b, m, deterministic)
+}
+func (m *Announcement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Announcement.Merge(m, src)
+}
+func (m *Announcement) XXX_Size() int {
+	return xxx_messageInfo_Announcement.Size(m)
+}
+func (m *Announcement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Announcement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Announcement proto.InternalMessageInfo
+
+func (m *Announcement) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type Txn struct {
+	Payload []byte `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	// Origin is a node the txn is taken by from client, it signs the txn.
+	Origin               string   `protobuf:"bytes,3,opt,name=Origin,proto3" json:"Origin,omitempty"`
+	Sign                 string   `protobuf:"bytes,4,opt,name=Sign,proto3" json:"Sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Txn) Reset()         { *m = Txn{} }
+func (m *Txn) String() string { return proto.CompactTextString(m) }
+func (*Txn) ProtoMessage()    {}
+func (*Txn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{14}
+}
+
+func (m *Txn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Txn.Unmarshal(m, b)
+}
+func (m *Txn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Txn.Marshal(b, m, deterministic)
+}
+func (m *Txn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Txn.Merge(m, src)
+}
+func (m *Txn) XXX_Size() int {
+	return xxx_messageInfo_Txn.Size(m)
+}
+func (m *Txn) XXX_DiscardUnknown() {
+	xxx_messageInfo_Txn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Txn proto.InternalMessageInfo
+
+func (m *Txn) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Txn) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *Txn) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+type TxnsBatch struct {
+	Txns                 []*Txn   `protobuf:"bytes,1,rep,name=Txns,proto3" json:"Txns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxnsBatch) Reset()         { *m = TxnsBatch{} }
+func (m *TxnsBatch) String() string { return proto.CompactTextString(m) }
+func (*TxnsBatch) ProtoMessage()    {}
+func (*TxnsBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{15}
+}
+
+func (m *TxnsBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxnsBatch.Unmarshal(m, b)
+}
+func (m *TxnsBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxnsBatch.Marshal(b, m, deterministic)
+}
+func (m *TxnsBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnsBatch.Merge(m, src)
+}
+func (m *TxnsBatch) XXX_Size() int {
+	return xxx_messageInfo_TxnsBatch.Size(m)
+}
+func (m *TxnsBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnsBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnsBatch proto.InternalMessageInfo
+
+func (m *TxnsBatch) GetTxns() []*Txn {
+	if m != nil {
+		return m.Txns
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*KnownEvents)(nil), "api.KnownEvents")
 	proto.RegisterMapType((map[string]uint64)(nil), "api.KnownEvents.LastsEntry")
 	proto.RegisterType((*EventRequest)(nil), "api.EventRequest")
 	proto.RegisterType((*PeerRequest)(nil), "api.PeerRequest")
 	proto.RegisterType((*PeerInfo)(nil), "api.PeerInfo")
+	proto.RegisterType((*PeersRequest)(nil), "api.PeersRequest")
+	proto.RegisterType((*PeerList)(nil), "api.PeerList")
+	proto.RegisterType((*Hello)(nil), "api.Hello")
+	proto.RegisterType((*Checkpoint)(nil), "api.Checkpoint")
+	proto.RegisterType((*EpochProof)(nil), "api.EpochProof")
+	proto.RegisterMapType((map[string]uint64)(nil), "api.EpochProof.ValidatorsEntry")
+	proto.RegisterType((*StateRequest)(nil), "api.StateRequest")
+	proto.RegisterType((*StateNodes)(nil), "api.StateNodes")
+	proto.RegisterType((*Interval)(nil), "api.Interval")
+	proto.RegisterType((*EventsRequest)(nil), "api.EventsRequest")
+	proto.RegisterMapType((map[string]*Interval)(nil), "api.EventsRequest.HeightsEntry")
+	proto.RegisterType((*Announcement)(nil), "api.Announcement")
+	proto.RegisterType((*Txn)(nil), "api.Txn")
+	proto.RegisterType((*TxnsBatch)(nil), "api.TxnsBatch")
 }
 
 func init() { proto.RegisterFile("service.proto", fileDescriptor_a0b84a42fa06f626) }
 
 var fileDescriptor_a0b84a42fa06f626 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4f, 0xef, 0xd2, 0x40,
-	0x10, 0xa5, 0x2d, 0x10, 0x98, 0x82, 0xc1, 0x8d, 0x31, 0x4d, 0xbd, 0x34, 0x4d, 0x4c, 0x7a, 0x90,
-	0x56, 0xf1, 0x42, 0x3c, 0x1a, 0xfe, 0x48, 0x30, 0x86, 0xd4, 0x4f, 0xb0, 0x94, 0x01, 0x36, 0xc2,
-	0x2e, 0x76, 0xb7, 0x60, 0xfd, 0x28, 0x7e, 0x5a, 0xd3, 0x69, 0x45, 0xa2, 0x87, 0xdf, 0xa5, 0x79,
-	0xef, 0xcd, 0xcc, 0x9b, 0xee, 0x1b, 0x18, 0x6a, 0xcc, 0xaf, 0x22, 0xc3, 0xf8, 0x92, 0x2b, 0xa3,
-	0x98, 0xc3, 0x2f, 0xc2, 0xff, 0x78, 0x10, 0xe6, 0x58, 0x6c, 0xe3, 0x4c, 0x9d, 0x93, 0x05, 0x97,
-	0x46, 0x9d, 0xc7, 0x7b, 0x55, 0xc8, 0x1d, 0x37, 0x42, 0xc9, 0xe4, 0xa0, 0xc6, 0x27, 0x9e, 0x1d,
-	0x51, 0x0b, 0x9d, 0xe8, 0x3c, 0x4b, 0x84, 0x34, 0x98, 0x27, 0x37, 0x91, 0x23, 0x7d, 0x6a, 0xa3,
-	0xf0, 0x27, 0xb8, 0x6b, 0xa9, 0x6e, 0x72, 0x7e, 0x45, 0x69, 0x34, 0x7b, 0x07, 0x9d, 0xcf, 0x5c,
-	0x1b, 0xed, 0x59, 0x81, 0x13, 0xb9, 0x93, 0x57, 0x31, 0xbf, 0x88, 0xf8, 0xa1, 0x21, 0xa6, 0xea,
-	0x5c, 0x9a, 0xbc, 0x4c, 0xeb, 0x4e, 0x7f, 0x0a, 0xf0, 0x57, 0x64, 0x23, 0x70, 0xbe, 0x61, 0xe9,
-	0x59, 0x81, 0x15, 0xf5, 0xd3, 0x0a, 0xb2, 0x17, 0xd0, 0xb9, 0xf2, 0x53, 0x81, 0x9e, 0x1d, 0x58,
-	0x51, 0x3b, 0xad, 0xc9, 0x07, 0x7b, 0x6a, 0x85, 0x1b, 0x18, 0x90, 0x6b, 0x8a, 0xdf, 0x0b, 0xd4,
-	0x86, 0xbd, 0x84, 0xee, 0x06, 0x31, 0x5f, 0xcd, 0x9a, 0xf1, 0x86, 0x55, 0x0e, 0x2b, 0xb9, 0xc3,
-	0x1f, 0x7f, 0x1c, 0x88, 0x30, 0x06, 0xed, 0x4f, 0x5c, 0x1f, 0x3d, 0x27, 0xb0, 0xa2, 0x41, 0x4a,
-	0x38, 0x7c, 0x0d, 0x6e, 0x35, 0xf3, 0x84, 0x61, 0xb8, 0x80, 0x1e, 0x21, 0xb9, 0x57, 0xec, 0x19,
-	0xd8, 0xf7, 0xba, 0xbd, 0x9a, 0xd1, 0x4c, 0xb1, 0x5d, 0x63, 0x49, 0xdb, 0x06, 0x69, 0xc3, 0x68,
-	0x9d, 0xd2, 0x86, 0xd6, 0xf5, 0x53, 0xc2, 0x93, 0x5f, 0x16, 0xb4, 0xbf, 0xa8, 0x1d, 0xb2, 0x09,
-	0xc0, 0xd7, 0x52, 0x66, 0x4d, 0x88, 0xa3, 0x7f, 0x53, 0xf3, 0xff, 0x53, 0xc2, 0x16, 0x7b, 0x03,
-	0xbd, 0x25, 0x1a, 0xa2, 0xec, 0x39, 0xd5, 0x1f, 0xc3, 0xf0, 0xdd, 0x98, 0xae, 0x44, 0x5a, 0xd8,
-	0x62, 0x6f, 0xc1, 0x5d, 0xa2, 0xb9, 0xff, 0x75, 0x6d, 0xf8, 0xf0, 0x56, 0x7f, 0x78, 0x57, 0xaa,
-	0x86, 0xb0, 0xb5, 0xed, 0xd2, 0x81, 0xdf, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x5e, 0xc6, 0x6a,
-	0x2d, 0x3a, 0x02, 0x00, 0x00,
+	// 958 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x6f, 0x6f, 0x1a, 0xc7,
+	0x13, 0xe6, 0xe0, 0x6c, 0xe3, 0xe1, 0xb0, 0x9d, 0xd5, 0x4f, 0xd6, 0xe9, 0xf2, 0x93, 0x8a, 0x36,
+	0x4a, 0x4b, 0xab, 0xe6, 0x48, 0x9d, 0x56, 0x4a, 0x23, 0x45, 0x55, 0xec, 0x10, 0x9b, 0xc6, 0x49,
+	0xdd, 0x03, 0xe5, 0xfd, 0x72, 0x2c, 0xb0, 0xf2, 0xb1, 0x4b, 0x6e, 0x17, 0x1b, 0xbe, 0x44, 0x3f,
+	0x42, 0xbf, 0x43, 0xdf, 0xf5, 0xe3, 0x55, 0xfb, 0xe7, 0xe0, 0x8c, 0x6b, 0x45, 0x7d, 0x83, 0x66,
+	0xe6, 0x66, 0x66, 0x9f, 0x7d, 0xe6, 0xd9, 0x01, 0x9a, 0x92, 0xe6, 0x37, 0x2c, 0xa5, 0xf1, 0x3c,
+	0x17, 0x4a, 0xa0, 0x1a, 0x99, 0xb3, 0xe8, 0xf1, 0x44, 0x88, 0x49, 0x46, 0x3b, 0x26, 0x34, 0x5c,
+	0x8c, 0x3b, 0x74, 0x36, 0x57, 0x2b, 0x9b, 0x11, 0x9d, 0x4e, 0x98, 0x9a, 0x2e, 0x86, 0x71, 0x2a,
+	0x66, 0x9d, 0x77, 0x84, 0x2b, 0x31, 0x7b, 0x36, 0x16, 0x0b, 0x3e, 0x22, 0x8a, 0x09, 0xde, 0x99,
+	0x88, 0x67, 0x19, 0x49, 0xa7, 0x54, 0x32, 0xd9, 0x91, 0x79, 0xda, 0x61, 0x5c, 0xd1, 0xbc, 0x73,
+	0xcb, 0x72, 0x6a, 0x7e, 0x6c, 0x0f, 0xfc, 0xa7, 0x07, 0x8d, 0xf7, 0x5c, 0xdc, 0xf2, 0xee, 0x0d,
+	0xe5, 0x4a, 0xa2, 0x1f, 0x60, 0xe7, 0x92, 0x48, 0x25, 0x43, 0xaf, 0x55, 0x6b, 0x37, 0x4e, 0x1e,
+	0xc7, 0x64, 0xce, 0xe2, 0x52, 0x42, 0x6c, 0xbe, 0x76, 0xb9, 0xca, 0x57, 0x89, 0xcd, 0x44, 0x18,
+	0x82, 0x4b, 0x71, 0x4b, 0xa5, 0x3a, 0xcd, 0x44, 0x7a, 0xfd, 0x31, 0xac, 0xb6, 0xbc, 0xb6, 0x9f,
+	0xdc, 0x89, 0x45, 0x2f, 0x01, 0x36, 0x85, 0xe8, 0x08, 0x6a, 0xd7, 0x74, 0x15, 0x7a, 0x2d, 0xaf,
+	0xbd, 0x9f, 0x68, 0x13, 0xfd, 0x0f, 0x76, 0x6e, 0x48, 0xb6, 0xa0, 0xae, 0xd8, 0x3a, 0xaf, 0xaa,
+	0x2f, 0x3d, 0x7c, 0x05, 0x81, 0x39, 0x39, 0xa1, 0x9f, 0x17, 0x54, 0x2a, 0x74, 0x0c, 0xbb, 0x57,
+	0x94, 0xe6, 0xbd, 0xb7, 0xae, 0xdc, 0x79, 0xba, 0x43, 0x8f, 0x8f, 0xe8, 0xb2, 0xe8, 0x60, 0x1c,
+	0x84, 0xc0, 0xbf, 0x20, 0x72, 0x1a, 0xd6, 0x5a, 0x5e, 0x3b, 0x48, 0x8c, 0x8d, 0x9f, 0x42, 0x43,
+	0xd7, 0x7c, 0xa1, 0xa1, 0x66, 0xa6, 0x6e, 0x4c, 0x3e, 0x16, 0xe8, 0x00, 0xaa, 0xeb, 0x84, 0x6a,
+	0xef, 0xad, 0x29, 0x5a, 0x0c, 0xdf, 0xd3, 0x95, 0x39, 0x2e, 0x48, 0x9c, 0x67, 0xce, 0x13, 0x52,
+	0x99, 0xf3, 0xf6, 0x13, 0x63, 0xeb, 0xdb, 0xf6, 0xe9, 0xe7, 0xd0, 0x37, 0xb8, 0xb4, 0xa9, 0xb1,
+	0xbe, 0x19, 0x8d, 0x72, 0x19, 0xee, 0xb4, 0x6a, 0xed, 0xfd, 0xc4, 0x3a, 0x28, 0x82, 0xfa, 0x27,
+	0x9a, 0x4b, 0x26, 0xb8, 0x0c, 0x77, 0x5b, 0xb5, 0x76, 0x33, 0x59, 0xfb, 0xba, 0x6f, 0x9f, 0x4d,
+	0x78, 0xb8, 0x67, 0xfb, 0x6a, 0x1b, 0xb7, 0x20, 0xd0, 0xf8, 0x64, 0x71, 0x91, 0x23, 0xa8, 0x7d,
+	0x20, 0x4b, 0x03, 0xb2, 0x99, 0x68, 0x13, 0x77, 0xed, 0x0d, 0x2e, 0x99, 0x54, 0xe8, 0x09, 0xec,
+	0x98, 0x6c, 0x37, 0xd8, 0xa6, 0x19, 0x6c, 0x71, 0xbf, 0xc4, 0x7e, 0xd3, 0xc0, 0x34, 0x64, 0x19,
+	0x56, 0x2d, 0x30, 0xe3, 0xe0, 0x15, 0xec, 0x5c, 0xd0, 0x2c, 0x13, 0x77, 0x10, 0x7a, 0x5b, 0x08,
+	0x31, 0x04, 0x67, 0x64, 0x4e, 0x86, 0x2c, 0x63, 0x8a, 0xd1, 0xa2, 0xc3, 0x9d, 0x18, 0x0a, 0x61,
+	0xef, 0x9c, 0x72, 0xad, 0x48, 0x37, 0x90, 0xc2, 0xd5, 0x7c, 0x5e, 0x50, 0x36, 0x99, 0x2a, 0x47,
+	0x93, 0xf3, 0x30, 0x01, 0x38, 0x9b, 0xd2, 0xf4, 0x7a, 0x2e, 0x18, 0x57, 0xe8, 0x3b, 0xf0, 0xcf,
+	0x68, 0xae, 0xcc, 0x15, 0x1b, 0x27, 0xc7, 0xb1, 0xd1, 0xb1, 0x51, 0x98, 0x0e, 0xb3, 0x31, 0x4b,
+	0x89, 0xa2, 0x89, 0xc9, 0x41, 0xdf, 0xc0, 0x6e, 0x77, 0x2e, 0xd2, 0xa9, 0x45, 0xd2, 0x38, 0x39,
+	0x34, 0x17, 0x36, 0xa1, 0xab, 0x5c, 0x88, 0x71, 0xe2, 0x3e, 0xe3, 0xbf, 0x3d, 0x80, 0x4d, 0x18,
+	0xfd, 0x02, 0xf0, 0x89, 0x64, 0x6c, 0x44, 0x94, 0x58, 0x93, 0xf5, 0xd5, 0x56, 0x6d, 0xbc, 0xc9,
+	0xb0, 0x2f, 0xa1, 0x54, 0xb2, 0x06, 0x59, 0xfd, 0x32, 0xc8, 0xe8, 0x35, 0x1c, 0x6e, 0xb5, 0xfa,
+	0x4f, 0x6f, 0xe3, 0x6b, 0x08, 0xfa, 0x4a, 0x77, 0xdb, 0x48, 0x59, 0x2b, 0x9c, 0x5a, 0xdc, 0x41,
+	0xe2, 0x3c, 0x8c, 0x01, 0x4c, 0xde, 0x47, 0x31, 0xa2, 0x66, 0xc8, 0xc6, 0x70, 0x49, 0xd6, 0xc1,
+	0x31, 0xd4, 0x7b, 0x7a, 0x43, 0xdc, 0x90, 0x4c, 0xab, 0xed, 0x5d, 0x2e, 0x66, 0x06, 0x84, 0x9f,
+	0x18, 0x5b, 0xbf, 0x80, 0x81, 0x70, 0x10, 0xaa, 0x03, 0x81, 0xff, 0xf2, 0xa0, 0x69, 0x57, 0x42,
+	0x71, 0xfa, 0xcf, 0xb0, 0x67, 0xa7, 0xb6, 0x45, 0x5b, 0x39, 0x29, 0x76, 0x19, 0x96, 0xb6, 0x22,
+	0x5f, 0x0b, 0xeb, 0x03, 0x59, 0x9e, 0xae, 0x14, 0x95, 0xee, 0x88, 0xb5, 0x1f, 0xf5, 0x20, 0x28,
+	0x17, 0xfd, 0x0b, 0x41, 0x4f, 0xca, 0x04, 0x15, 0xd2, 0x2e, 0x2e, 0xb3, 0xc5, 0xd7, 0x1b, 0xce,
+	0xc5, 0x82, 0xa7, 0x74, 0x46, 0xf9, 0xc3, 0x7c, 0xfd, 0x0e, 0xb5, 0xc1, 0x92, 0x6b, 0xb9, 0x5e,
+	0x91, 0x55, 0x26, 0xc8, 0xc8, 0x9c, 0x16, 0x24, 0x85, 0xab, 0x0b, 0x7f, 0xcb, 0xd9, 0x84, 0x71,
+	0xf7, 0xd0, 0x9d, 0xb7, 0x7e, 0xa6, 0xfe, 0xe6, 0x99, 0xfe, 0xea, 0xd7, 0xab, 0x47, 0x35, 0xfc,
+	0x2d, 0xec, 0x0f, 0x96, 0x5c, 0x9e, 0x12, 0x95, 0x4e, 0xd1, 0xff, 0xc1, 0xd7, 0x8e, 0xa3, 0xa9,
+	0x6e, 0xf0, 0x0e, 0x96, 0x3c, 0x31, 0xd1, 0x93, 0x3f, 0x7c, 0xf0, 0xf5, 0x4c, 0xd0, 0x09, 0x40,
+	0x7f, 0xc5, 0x53, 0xb7, 0x99, 0x8f, 0xb6, 0x57, 0x71, 0x74, 0x2f, 0x82, 0x2b, 0xe8, 0x7b, 0xa8,
+	0x9f, 0x53, 0x65, 0x5c, 0xf4, 0x68, 0xc3, 0xbf, 0xa3, 0x3f, 0x6a, 0x58, 0x39, 0x9a, 0x18, 0xae,
+	0xa0, 0xe7, 0xd0, 0x38, 0xa7, 0x6a, 0xbd, 0xe5, 0x8e, 0xd6, 0x4b, 0xa1, 0xc8, 0xbf, 0xbb, 0x26,
+	0x70, 0x05, 0xbd, 0x82, 0xe6, 0x39, 0x55, 0xa5, 0x37, 0x79, 0x1c, 0xdb, 0xbf, 0xa8, 0xb8, 0xf8,
+	0x8b, 0x8a, 0xbb, 0xfa, 0x2f, 0x2a, 0xb2, 0xef, 0x6d, 0x93, 0x88, 0x2b, 0xe8, 0x27, 0x53, 0x5b,
+	0x52, 0xa2, 0x05, 0x58, 0x96, 0x70, 0x74, 0xb8, 0x09, 0x59, 0x5d, 0x56, 0xd0, 0x0b, 0xad, 0xf2,
+	0x9c, 0x92, 0x99, 0x23, 0x02, 0xdd, 0x97, 0xd5, 0xd6, 0xbd, 0x9e, 0x7b, 0xe8, 0x35, 0x1c, 0x14,
+	0xa3, 0x76, 0x65, 0xf6, 0xb0, 0xf2, 0xfc, 0xa3, 0x07, 0xb0, 0xe3, 0x0a, 0x8a, 0x0d, 0x8d, 0x76,
+	0x29, 0x3e, 0x5a, 0x73, 0x20, 0xef, 0xd3, 0xa2, 0x77, 0x2b, 0xae, 0xa0, 0xa7, 0xb0, 0x7f, 0x41,
+	0xf8, 0x48, 0x4e, 0xc9, 0x35, 0x45, 0x60, 0xbe, 0x9a, 0x95, 0x19, 0x95, 0x6c, 0x5c, 0x41, 0x3f,
+	0x42, 0xbd, 0x4f, 0xf9, 0x48, 0x8f, 0x19, 0x1d, 0x14, 0x63, 0xb7, 0xa2, 0x78, 0x18, 0xcc, 0x70,
+	0xd7, 0x44, 0x5e, 0xfc, 0x33, 0x00, 0x41, 0x3a, 0x77, 0xeb, 0x21, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +905,13 @@ type NodeClient interface {
 	SyncEvents(ctx context.Context, in *KnownEvents, opts ...grpc.CallOption) (*KnownEvents, error)
 	GetEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*wire.Event, error)
 	GetPeerInfo(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*PeerInfo, error)
+	GetCheckpoint(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Checkpoint, error)
+	GetStateNodes(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateNodes, error)
+	StreamEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Node_StreamEventsClient, error)
+	AnnounceEvents(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetPeers(ctx context.Context, in *PeersRequest, opts ...grpc.CallOption) (*PeerList, error)
+	Handshake(ctx context.Context, in *Hello, opts ...grpc.CallOption) (*Hello, error)
+	SendTxns(ctx context.Context, in *TxnsBatch, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type nodeClient struct {
@@ -300,11 +949,104 @@ func (c *nodeClient) GetPeerInfo(ctx context.Context, in *PeerRequest, opts ...g
 	return out, nil
 }
 
+func (c *nodeClient) GetCheckpoint(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Checkpoint, error) {
+	out := new(Checkpoint)
+	err := c.cc.Invoke(ctx, "/api.Node/GetCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetStateNodes(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateNodes, error) {
+	out := new(StateNodes)
+	err := c.cc.Invoke(ctx, "/api.Node/GetStateNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) StreamEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Node_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Node_serviceDesc.Streams[0], "/api.Node/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Node_StreamEventsClient interface {
+	Recv() (*wire.Event, error)
+	grpc.ClientStream
+}
+
+type nodeStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeStreamEventsClient) Recv() (*wire.Event, error) {
+	m := new(wire.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeClient) AnnounceEvents(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.Node/AnnounceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetPeers(ctx context.Context, in *PeersRequest, opts ...grpc.CallOption) (*PeerList, error) {
+	out := new(PeerList)
+	err := c.cc.Invoke(ctx, "/api.Node/GetPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Handshake(ctx context.Context, in *Hello, opts ...grpc.CallOption) (*Hello, error) {
+	out := new(Hello)
+	err := c.cc.Invoke(ctx, "/api.Node/Handshake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) SendTxns(ctx context.Context, in *TxnsBatch, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.Node/SendTxns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 type NodeServer interface {
 	SyncEvents(context.Context, *KnownEvents) (*KnownEvents, error)
 	GetEvent(context.Context, *EventRequest) (*wire.Event, error)
 	GetPeerInfo(context.Context, *PeerRequest) (*PeerInfo, error)
+	GetCheckpoint(context.Context, *empty.Empty) (*Checkpoint, error)
+	GetStateNodes(context.Context, *StateRequest) (*StateNodes, error)
+	StreamEvents(*EventsRequest, Node_StreamEventsServer) error
+	AnnounceEvents(context.Context, *Announcement) (*empty.Empty, error)
+	GetPeers(context.Context, *PeersRequest) (*PeerList, error)
+	Handshake(context.Context, *Hello) (*Hello, error)
+	SendTxns(context.Context, *TxnsBatch) (*empty.Empty, error)
 }
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
@@ -365,6 +1107,135 @@ func _Node_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_GetCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/GetCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetCheckpoint(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetStateNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetStateNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/GetStateNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetStateNodes(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServer).StreamEvents(m, &nodeStreamEventsServer{stream})
+}
+
+type Node_StreamEventsServer interface {
+	Send(*wire.Event) error
+	grpc.ServerStream
+}
+
+type nodeStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeStreamEventsServer) Send(m *wire.Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Node_AnnounceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Announcement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).AnnounceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/AnnounceEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).AnnounceEvents(ctx, req.(*Announcement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/GetPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetPeers(ctx, req.(*PeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Handshake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hello)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Handshake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/Handshake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Handshake(ctx, req.(*Hello))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_SendTxns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnsBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SendTxns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/SendTxns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SendTxns(ctx, req.(*TxnsBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Node_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Node",
 	HandlerType: (*NodeServer)(nil),
@@ -381,7 +1252,37 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeerInfo",
 			Handler:    _Node_GetPeerInfo_Handler,
 		},
+		{
+			MethodName: "GetCheckpoint",
+			Handler:    _Node_GetCheckpoint_Handler,
+		},
+		{
+			MethodName: "GetStateNodes",
+			Handler:    _Node_GetStateNodes_Handler,
+		},
+		{
+			MethodName: "AnnounceEvents",
+			Handler:    _Node_AnnounceEvents_Handler,
+		},
+		{
+			MethodName: "GetPeers",
+			Handler:    _Node_GetPeers_Handler,
+		},
+		{
+			MethodName: "Handshake",
+			Handler:    _Node_Handshake_Handler,
+		},
+		{
+			MethodName: "SendTxns",
+			Handler:    _Node_SendTxns_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Node_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
@@ -59,17 +59,186 @@ func (m *PeerIDs) GetIDs() []string {
 	return nil
 }
 
+type PeerBan struct {
+	Until                int64    `protobuf:"varint,1,opt,name=Until,proto3" json:"Until,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerBan) Reset()         { *m = PeerBan{} }
+func (m *PeerBan) String() string { return proto.CompactTextString(m) }
+func (*PeerBan) ProtoMessage()    {}
+func (*PeerBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5219adf996163c1, []int{1}
+}
+
+func (m *PeerBan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerBan.Unmarshal(m, b)
+}
+func (m *PeerBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerBan.Marshal(b, m, deterministic)
+}
+func (m *PeerBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerBan.Merge(m, src)
+}
+func (m *PeerBan) XXX_Size() int {
+	return xxx_messageInfo_PeerBan.Size(m)
+}
+func (m *PeerBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerBan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerBan proto.InternalMessageInfo
+
+func (m *PeerBan) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *PeerBan) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type PeerAddr struct {
+	Host                 string   `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=Source,proto3" json:"Source,omitempty"`
+	LastSeen             int64    `protobuf:"varint,3,opt,name=LastSeen,proto3" json:"LastSeen,omitempty"`
+	LastFail             int64    `protobuf:"varint,4,opt,name=LastFail,proto3" json:"LastFail,omitempty"`
+	Fails                uint32   `protobuf:"varint,5,opt,name=Fails,proto3" json:"Fails,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerAddr) Reset()         { *m = PeerAddr{} }
+func (m *PeerAddr) String() string { return proto.CompactTextString(m) }
+func (*PeerAddr) ProtoMessage()    {}
+func (*PeerAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5219adf996163c1, []int{2}
+}
+
+func (m *PeerAddr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerAddr.Unmarshal(m, b)
+}
+func (m *PeerAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerAddr.Marshal(b, m, deterministic)
+}
+func (m *PeerAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAddr.Merge(m, src)
+}
+func (m *PeerAddr) XXX_Size() int {
+	return xxx_messageInfo_PeerAddr.Size(m)
+}
+func (m *PeerAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAddr proto.InternalMessageInfo
+
+func (m *PeerAddr) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *PeerAddr) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PeerAddr) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *PeerAddr) GetLastFail() int64 {
+	if m != nil {
+		return m.LastFail
+	}
+	return 0
+}
+
+func (m *PeerAddr) GetFails() uint32 {
+	if m != nil {
+		return m.Fails
+	}
+	return 0
+}
+
+type PeerAddrs struct {
+	Addrs                []*PeerAddr `protobuf:"bytes,1,rep,name=Addrs,proto3" json:"Addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PeerAddrs) Reset()         { *m = PeerAddrs{} }
+func (m *PeerAddrs) String() string { return proto.CompactTextString(m) }
+func (*PeerAddrs) ProtoMessage()    {}
+func (*PeerAddrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5219adf996163c1, []int{3}
+}
+
+func (m *PeerAddrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerAddrs.Unmarshal(m, b)
+}
+func (m *PeerAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerAddrs.Marshal(b, m, deterministic)
+}
+func (m *PeerAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAddrs.Merge(m, src)
+}
+func (m *PeerAddrs) XXX_Size() int {
+	return xxx_messageInfo_PeerAddrs.Size(m)
+}
+func (m *PeerAddrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAddrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAddrs proto.InternalMessageInfo
+
+func (m *PeerAddrs) GetAddrs() []*PeerAddr {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PeerIDs)(nil), "api.PeerIDs")
+	proto.RegisterType((*PeerBan)(nil), "api.PeerBan")
+	proto.RegisterType((*PeerAddr)(nil), "api.PeerAddr")
+	proto.RegisterType((*PeerAddrs)(nil), "api.PeerAddrs")
 }
 
 func init() { proto.RegisterFile("stored.proto", fileDescriptor_c5219adf996163c1) }
 
 var fileDescriptor_c5219adf996163c1 = []byte{
-	// 75 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x2e, 0xc9, 0x2f,
-	0x4a, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0x54, 0x92, 0xe6,
-	0x62, 0x0f, 0x48, 0x4d, 0x2d, 0xf2, 0x74, 0x29, 0x16, 0x12, 0xe0, 0x62, 0xf6, 0x74, 0x29, 0x96,
-	0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x02, 0x31, 0x93, 0xd8, 0xc0, 0x0a, 0x8d, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x95, 0xd2, 0x54, 0x9f, 0x38, 0x00, 0x00, 0x00,
+	// 218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xc1, 0x4a, 0xc4, 0x30,
+	0x14, 0x45, 0x89, 0x99, 0x8e, 0x93, 0xa7, 0x03, 0xf2, 0x10, 0x09, 0xba, 0x29, 0x71, 0xd3, 0x55,
+	0x11, 0xc5, 0x0f, 0x50, 0x87, 0xc1, 0x01, 0x17, 0x92, 0xc1, 0x0f, 0x88, 0x36, 0x8b, 0x40, 0x49,
+	0x4a, 0x92, 0xfe, 0x81, 0x1f, 0x2e, 0x79, 0xb1, 0xba, 0xea, 0x3d, 0x3d, 0x5c, 0x72, 0x13, 0x38,
+	0x4f, 0x39, 0x44, 0x3b, 0xf4, 0x53, 0x0c, 0x39, 0x20, 0x37, 0x93, 0x53, 0x37, 0x70, 0xfa, 0x6e,
+	0x6d, 0x3c, 0xec, 0x12, 0x5e, 0x00, 0x3f, 0xec, 0x92, 0x64, 0x2d, 0xef, 0x84, 0x2e, 0x51, 0x3d,
+	0x56, 0xf9, 0x6c, 0x3c, 0x5e, 0x42, 0xf3, 0xe1, 0xb3, 0x1b, 0x25, 0x6b, 0x59, 0xc7, 0x75, 0x85,
+	0xf2, 0xf7, 0x25, 0xcc, 0x3e, 0xcb, 0x93, 0x96, 0x75, 0x5b, 0x5d, 0x41, 0x7d, 0x33, 0xd8, 0x94,
+	0xde, 0xd3, 0x30, 0x44, 0x44, 0x58, 0xbd, 0x86, 0x94, 0xa9, 0x27, 0x34, 0x65, 0xbc, 0x82, 0xf5,
+	0x31, 0xcc, 0xf1, 0xcb, 0x52, 0x4f, 0xe8, 0x5f, 0xc2, 0x6b, 0xd8, 0xbc, 0x99, 0x94, 0x8f, 0xd6,
+	0x7a, 0xc9, 0xe9, 0x9c, 0x3f, 0x5e, 0xdc, 0xde, 0xb8, 0x51, 0xae, 0xfe, 0xdd, 0xde, 0xd4, 0x19,
+	0xe5, 0x9b, 0x64, 0x53, 0x67, 0x10, 0xa8, 0x3b, 0x10, 0xcb, 0x8a, 0x84, 0xb7, 0xd0, 0x50, 0xa0,
+	0xeb, 0x9d, 0xdd, 0x6f, 0x7b, 0x33, 0xb9, 0x7e, 0xd1, 0xba, 0xba, 0xcf, 0x35, 0x3d, 0xcc, 0xc3,
+	0xcf, 0x00, 0x12, 0xc9, 0x7f, 0x65, 0x28, 0x01, 0x00, 0x00,
 }
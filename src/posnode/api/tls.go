@@ -0,0 +1,272 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// certValidity is a validity period of self-signed certificate.
+const certValidity = 10 * 365 * 24 * time.Hour
+
+// ServerTLS makes server-side TLS credentials with certificate of node key.
+// Clients should present certificates of their keys.
+func ServerTLS(key *common.PrivateKey, genesis hash.Hash) (credentials.TransportCredentials, error) {
+	cert, err := certOf(key, genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:            tls.VersionTLS12,
+		Certificates:          []tls.Certificate{cert},
+		ClientAuth:            tls.RequireAnyClientCert,
+		VerifyPeerCertificate: verifyCert(genesis, hash.EmptyPeer),
+	}), nil
+}
+
+// ClientTLS makes client-side TLS credentials with certificate of node key
+// to connect to the server peer. Server certificate is checked to be self-signed
+// by key of the server, so handshake fails if another peer answers.
+// Empty server is allowed to discover unknown peer,
+// its ID is known after call (see ServerPeerID).
+func ClientTLS(key *common.PrivateKey, genesis hash.Hash, server hash.Peer) (credentials.TransportCredentials, error) {
+	cert, err := certOf(key, genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		// there are no CA, so certificate is checked by VerifyPeerCertificate
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: verifyCert(genesis, server),
+	}), nil
+}
+
+// ServerTLSAuth makes server-side interceptor to identify client by TLS certificate.
+// It replaces ServerAuth, so messages are not signed.
+func ServerTLSAuth() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := tlsIdentify(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ServerStreamTLSAuth makes server-side stream interceptor to identify client by TLS certificate.
+// It replaces ServerStreamAuth, so messages are not signed.
+func ServerStreamTLSAuth() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := tlsIdentify(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &identifiedStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+	}
+}
+
+// ClientTLSAuth makes client-side interceptor to identify server by TLS certificate.
+// It replaces ClientAuth, so messages are not signed.
+func ClientTLSAuth() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req interface{}, resp interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var server peer.Peer
+		opts = append(opts, grpc.Peer(&server))
+
+		err := invoker(ctx, method, req, resp, cc, opts...)
+		if err != nil {
+			return err
+		}
+
+		if set, ok := ctx.Value(peerID{}).(func(hash.Peer)); ok {
+			set(tlsPeerID(server.AuthInfo))
+		}
+
+		return nil
+	}
+}
+
+// ClientStreamTLSAuth makes client-side stream interceptor to identify server by TLS certificate.
+// Server peer ID is known after the stream end.
+func ClientStreamTLSAuth() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		server := &peer.Peer{}
+		opts = append(opts, grpc.Peer(server))
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		set, _ := ctx.Value(peerID{}).(func(hash.Peer))
+		return &identifyingStream{
+			ClientStream: cs,
+			identify: func() {
+				if set != nil {
+					set(tlsPeerID(server.AuthInfo))
+				}
+			},
+		}, nil
+	}
+}
+
+// identifiedStream is a server stream with client's ID in context.
+type identifiedStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+// Context returns context with client's ID.
+func (s *identifiedStream) Context() context.Context {
+	return s.ctx
+}
+
+// identifyingStream identifies server at the stream end.
+type identifyingStream struct {
+	grpc.ClientStream
+
+	identify func()
+}
+
+// RecvMsg receives message and identifies server at the stream end.
+func (s *identifyingStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == io.EOF {
+		s.identify()
+	}
+	return err
+}
+
+/*
+ * Utils:
+ */
+
+// tlsIdentify puts TLS client's ID into context.
+func tlsIdentify(ctx context.Context) (context.Context, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no peer")
+	}
+
+	id := tlsPeerID(p.AuthInfo)
+	if id.IsEmpty() {
+		return nil, status.Error(codes.Unauthenticated, "no TLS certificate")
+	}
+
+	return context.WithValue(ctx, peerID{}, id), nil
+}
+
+// tlsPeerID returns ID of peer key from TLS certificate.
+func tlsPeerID(info credentials.AuthInfo) hash.Peer {
+	tlsInfo, ok := info.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) < 1 {
+		return hash.EmptyPeer
+	}
+
+	pub, ok := tlsInfo.State.PeerCertificates[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return hash.EmptyPeer
+	}
+
+	return hash.PeerOfPubkey((*common.PublicKey)(pub))
+}
+
+// certOf makes certificate self-signed by node key.
+// Genesis is included to not connect peers of other networks.
+func certOf(key *common.PrivateKey, genesis hash.Hash) (tls.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	id := hash.PeerOfPubkey(key.Public())
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:         id.Hex(),
+			OrganizationalUnit: []string{genesis.Hex()},
+		},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	priv := (*ecdsa.PrivateKey)(key)
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  priv,
+	}, nil
+}
+
+// verifyCert checks peer certificate is self-signed by peer key for the same genesis
+// and the peer is the expected one if it is not empty.
+// Key possession is proved by TLS handshake.
+func verifyCert(genesis hash.Hash, expected hash.Peer) func([][]byte, [][]*x509.Certificate) error {
+	return func(raw [][]byte, _ [][]*x509.Certificate) error {
+		if len(raw) != 1 {
+			return errors.New("single self-signed certificate expected")
+		}
+
+		cert, err := x509.ParseCertificate(raw[0])
+		if err != nil {
+			return err
+		}
+
+		if len(cert.Subject.OrganizationalUnit) != 1 || cert.Subject.OrganizationalUnit[0] != genesis.Hex() {
+			return errors.New("peer uses another genesis")
+		}
+
+		now := time.Now()
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			return errors.New("certificate is expired or not yet valid")
+		}
+
+		pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("certificate of not ECDSA key")
+		}
+		id := hash.PeerOfPubkey((*common.PublicKey)(pub))
+		if cert.Subject.CommonName != id.Hex() {
+			return fmt.Errorf("certificate is not of peer %s", cert.Subject.CommonName)
+		}
+		if !expected.IsEmpty() && id != expected {
+			return fmt.Errorf("peer %s answered instead of %s", id.String(), expected.String())
+		}
+
+		return cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature)
+	}
+}
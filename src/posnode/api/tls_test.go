@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fortytw2/leaktest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/network"
+)
+
+func TestTLS(t *testing.T) {
+	defer leaktest.CheckTimeout(t, time.Second)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := "client.fake"
+	dialer := grpc.WithContextDialer(network.FakeDialer(from))
+
+	// keys
+	serverKey := crypto.GenerateKey()
+	serverID := hash.PeerOfPubkey(serverKey.Public())
+	clientKey := crypto.GenerateKey()
+	clientID := hash.PeerOfPubkey(clientKey.Public())
+
+	// service
+	svc := NewMockNodeServer(ctrl)
+	svc.EXPECT().
+		SyncEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *KnownEvents) (*KnownEvents, error) {
+			assert.Equal(t, clientID, GrpcPeerID(ctx))
+			return &KnownEvents{}, nil
+		}).
+		AnyTimes()
+
+	// server
+	creds, err := ServerTLS(serverKey, gen)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, addr, stop := StartService("server.fake:0", serverKey, gen, creds, svc, Limits{}, t.Logf, network.FakeListener)
+	defer stop()
+
+	call := func(opts ...grpc.DialOption) (hash.Peer, error) {
+		conn, err := grpc.DialContext(context.Background(), addr, append(opts, dialer)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		client := NewNodeClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		id, ctx := ServerPeerID(ctx)
+		_, err = client.SyncEvents(ctx, &KnownEvents{})
+		return *id, err
+	}
+
+	t.Run("authorized", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		creds, err := ClientTLS(clientKey, gen, serverID)
+		if !assertar.NoError(err) {
+			return
+		}
+
+		id, err := call(
+			grpc.WithTransportCredentials(creds),
+			grpc.WithUnaryInterceptor(ClientTLSAuth()))
+		if !assertar.NoError(err) {
+			return
+		}
+		assertar.Equal(serverID, id)
+	})
+
+	t.Run("another genesis", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		creds, err := ClientTLS(clientKey, hash.FakeHash(), serverID)
+		if !assertar.NoError(err) {
+			return
+		}
+
+		_, err = call(
+			grpc.WithTransportCredentials(creds),
+			grpc.WithUnaryInterceptor(ClientTLSAuth()))
+		assertar.Error(err)
+	})
+
+	t.Run("another server", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		creds, err := ClientTLS(clientKey, gen, hash.FakePeer())
+		if !assertar.NoError(err) {
+			return
+		}
+
+		_, err = call(
+			grpc.WithTransportCredentials(creds),
+			grpc.WithUnaryInterceptor(ClientTLSAuth()))
+		assertar.Error(err)
+	})
+
+	t.Run("unknown server", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		creds, err := ClientTLS(clientKey, gen, hash.EmptyPeer)
+		if !assertar.NoError(err) {
+			return
+		}
+
+		id, err := call(
+			grpc.WithTransportCredentials(creds),
+			grpc.WithUnaryInterceptor(ClientTLSAuth()))
+		if !assertar.NoError(err) {
+			return
+		}
+		assertar.Equal(serverID, id)
+	})
+
+	t.Run("plaintext client", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		_, err := call(
+			grpc.WithInsecure(),
+			grpc.WithUnaryInterceptor(ClientAuth(clientKey, gen)))
+		assertar.Error(err)
+	})
+}
@@ -17,6 +17,9 @@ import (
 	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	// registers gzip compressor for clients supporting it
+	_ "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/peer"
 
 	"github.com/Fantom-foundation/go-lachesis/src/common"
@@ -29,7 +32,9 @@ func StartService(
 	bind string,
 	key *common.PrivateKey,
 	genesis hash.Hash,
+	creds credentials.TransportCredentials,
 	svc NodeServer,
+	limits Limits,
 	log func(string, ...interface{}),
 	listen network.ListenFunc,
 ) (
@@ -37,10 +42,24 @@ func StartService(
 	addr string,
 	stopAndWait func(),
 ) {
-	server = grpc.NewServer(
-		grpc.UnaryInterceptor(ServerAuth(key, genesis)),
+	unaryAuth, streamAuth := ServerAuth(key, genesis), ServerStreamAuth(key, genesis)
+	opts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(math.MaxInt32),
-		grpc.MaxSendMsgSize(math.MaxInt32))
+		grpc.MaxSendMsgSize(math.MaxInt32),
+	}
+	if creds != nil {
+		// TLS identifies peers, so messages are not signed
+		unaryAuth, streamAuth = ServerTLSAuth(), ServerStreamTLSAuth()
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	unaryHosts, streamHosts := ServerHostLimits(limits)
+	unaryLimits, streamLimits := ServerLimits(limits)
+	opts = append(opts,
+		grpc.UnaryInterceptor(chainUnary(unaryHosts, chainUnary(unaryAuth, unaryLimits))),
+		grpc.StreamInterceptor(chainStream(streamHosts, chainStream(streamAuth, streamLimits))))
+
+	server = grpc.NewServer(opts...)
 	RegisterNodeServer(server, svc)
 
 	listener := listen(bind)
@@ -63,6 +82,24 @@ func StartService(
 	return
 }
 
+// chainUnary makes outer interceptor to call inner one before handler.
+func chainUnary(outer, inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStream makes outer interceptor to call inner one before handler.
+func chainStream(outer, inner grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return outer(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return inner(srv, ss, info, handler)
+		})
+	}
+}
+
 // GrpcPeerHost extracts client's host from grpc context.
 func GrpcPeerHost(ctx context.Context) string {
 	p, ok := peer.FromContext(ctx)
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -9,6 +10,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/Fantom-foundation/go-lachesis/src/crypto"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
@@ -72,7 +76,7 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		AnyTimes()
 
 	// server
-	_, addr, stop := StartService(bind, serverKey, gen, svc, t.Logf, listen)
+	_, addr, stop := StartService(bind, serverKey, gen, nil, svc, Limits{}, t.Logf, listen)
 	defer stop()
 
 	t.Run("authorized", func(t *testing.T) {
@@ -91,7 +95,7 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		client := NewNodeClient(conn)
 
 		// SyncEvents() rpc
-		id1, ctx1 := ServerPeerID(nil)
+		id1, ctx1 := ServerPeerID(WithServerID(nil, serverID))
 		_, err = client.SyncEvents(ctx1, &KnownEvents{})
 		if !assertar.NoError(err) {
 			return
@@ -101,7 +105,7 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		}
 
 		// GetEvent() rpc
-		id2, ctx2 := ServerPeerID(nil)
+		id2, ctx2 := ServerPeerID(WithServerID(nil, serverID))
 		_, err = client.GetEvent(ctx2, &EventRequest{})
 		if !assertar.NoError(err) {
 			return
@@ -110,7 +114,7 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 			return
 		}
 
-		// GetPeerInfo() rpc
+		// GetPeerInfo() rpc, server may be unknown
 		id3, ctx3 := ServerPeerID(nil)
 		_, err = client.GetPeerInfo(ctx3, &PeerRequest{})
 		if !assertar.NoError(err) {
@@ -119,6 +123,85 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		if !assertar.Equal(serverID, *id3) {
 			return
 		}
+
+		// SyncEvents() rpc to unknown server
+		_, err = client.SyncEvents(context.Background(), &KnownEvents{})
+		if !assertar.Equal(codes.Unauthenticated, status.Code(err)) {
+			return
+		}
+
+		// SyncEvents() rpc to another server
+		_, err = client.SyncEvents(WithServerID(nil, clientID), &KnownEvents{})
+		if !assertar.Equal(codes.Unauthenticated, status.Code(err)) {
+			return
+		}
+	})
+
+	t.Run("replayed request", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		opts := append(opts,
+			grpc.WithInsecure(),
+		)
+		conn, err := grpc.DialContext(context.Background(), addr, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		client := NewNodeClient(conn)
+
+		req := &KnownEvents{}
+		md := signRequest(req, clientKey, gen.Bytes(), "/api.Node/SyncEvents", serverID)
+		md.Set("pub", clientKey.Public().Base64())
+		ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+		_, err = client.SyncEvents(ctx, req)
+		if !assertar.NoError(err) {
+			return
+		}
+
+		_, err = client.SyncEvents(ctx, req)
+		if !assertar.Equal(codes.Unauthenticated, status.Code(err)) {
+			return
+		}
+
+		// the same sign for another method
+		_, err = client.GetEvent(ctx, &EventRequest{})
+		if !assertar.Equal(codes.Unauthenticated, status.Code(err)) {
+			return
+		}
+	})
+
+	t.Run("expired request", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		opts := append(opts,
+			grpc.WithInsecure(),
+		)
+		conn, err := grpc.DialContext(context.Background(), addr, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		client := NewNodeClient(conn)
+
+		req := &KnownEvents{}
+		method := "/api.Node/SyncEvents"
+		md := signRequest(req, clientKey, gen.Bytes(), method, serverID)
+		md.Set("pub", clientKey.Public().Base64())
+		// resign with old time
+		old := time.Now().Add(-2 * authWindow)
+		md.Set("time", strconv.FormatInt(old.UnixNano(), 10))
+		R, S, _ := clientKey.Sign(requestDigest(req, gen.Bytes(), method, md).Bytes())
+		md.Set("sign", crypto.EncodeSignature(R, S))
+		ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+		_, err = client.SyncEvents(ctx, req)
+		if !assertar.Equal(codes.Unauthenticated, status.Code(err)) {
+			return
+		}
 	})
 
 	t.Run("unauthorized client", func(t *testing.T) {
@@ -168,3 +251,35 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 
 	// TODO: test client with unauthorized server.
 }
+
+func TestReplayCache(t *testing.T) {
+	assertar := assert.New(t)
+
+	c := newReplayCache()
+	now := time.Now()
+	expire := now.Add(authWindow)
+
+	client := hash.FakeHash()
+	for i := 0; i < maxClientReplays; i++ {
+		assertar.NoError(c.add(client, hash.Of([]byte(strconv.Itoa(i))), expire, now))
+	}
+	last := hash.Of([]byte(strconv.Itoa(maxClientReplays - 1)))
+	assertar.Error(c.add(client, last, expire, now), "replay is rejected")
+	assertar.Error(c.add(client, hash.FakeHash(), expire, now), "client is limited")
+
+	// the others are not locked out until cache is full
+	for i := 0; len(c.seen) < maxReplays; i++ {
+		other := hash.Of([]byte("client" + strconv.Itoa(i/maxClientReplays)))
+		if !assertar.NoError(c.add(other, hash.Of([]byte("req"+strconv.Itoa(i))), expire, now)) {
+			return
+		}
+	}
+	assertar.Error(c.add(hash.FakeHash(), hash.FakeHash(), expire, now), "full cache rejects new requests")
+	assertar.Error(c.add(client, last, expire, now), "unexpired request is not forgotten")
+
+	// expired are forgotten
+	later := expire.Add(time.Second)
+	assertar.NoError(c.add(client, hash.FakeHash(), later.Add(authWindow), later))
+	assertar.Equal(1, len(c.seen))
+	assertar.Equal(1, len(c.clients))
+}
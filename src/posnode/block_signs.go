@@ -0,0 +1,62 @@
+package posnode
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+// maxBlockSigns is a max count of own block signs in event.
+const maxBlockSigns = 10
+
+// blockSigns is a state of finalized blocks signing
+// to gossip signs with own events.
+type blockSigns struct {
+	lastSigned uint64
+}
+
+// popBlockSigns signs finalized blocks to include signs into new event.
+// Only the last maxBlockSigns blocks are signed if there are more.
+// Signs out of budget wait for the next event.
+// Call it under emitter lock.
+func (n *Node) popBlockSigns(budget *eventBudget) []*inter.BlockSign {
+	if n.consensus == nil {
+		return nil
+	}
+
+	last := n.consensus.LastBlockN()
+	if last <= n.blockSigns.lastSigned {
+		return nil
+	}
+	from := n.blockSigns.lastSigned + 1
+	if last-from >= maxBlockSigns {
+		from = last - maxBlockSigns + 1
+	}
+
+	// non-validator's signs have no sense
+	if n.consensus.StakeOf(n.ID) < 1 {
+		n.blockSigns.lastSigned = last
+		return nil
+	}
+
+	res := make([]*inter.BlockSign, 0, last-from+1)
+	for i := from; i <= last; i++ {
+		block := n.consensus.GetBlock(i)
+		if block == nil {
+			n.Warnf("block %d not found, so not signed", i)
+			n.blockSigns.lastSigned = i
+			continue
+		}
+		sign, err := inter.NewBlockSign(n.key, block)
+		if err != nil {
+			n.Fatal(err)
+		}
+		if !budget.TakeBytes(proto.Size(sign.ToWire())) {
+			break
+		}
+		res = append(res, sign)
+		n.blockSigns.lastSigned = i
+	}
+
+	return res
+}
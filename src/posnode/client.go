@@ -9,6 +9,8 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
@@ -18,9 +20,14 @@ type (
 	// connection wraps grpc.ClientConn
 	connection struct {
 		*grpc.ClientConn
+		key     string // see connKey
 		addr    string
+		id      hash.Peer
 		created time.Time
 		used    int
+
+		hs *handshake
+		sync.Mutex
 	}
 
 	// connPool is connections to peers.
@@ -30,6 +37,8 @@ type (
 
 		connectTimeout time.Duration
 		opts           []grpc.DialOption
+		// creds makes TLS credentials to connect to the peer, nil if TLS is off
+		creds func(hash.Peer) (credentials.TransportCredentials, error)
 
 		sync.RWMutex
 	}
@@ -51,9 +60,21 @@ func (n *Node) initClient() {
 		genesis = n.consensus.GetGenesisHash()
 	}
 
+	if !n.conf.TLS {
+		n.connPool.opts = append(n.connPool.opts,
+			grpc.WithInsecure(),
+			grpc.WithChainUnaryInterceptor(api.ClientAuth(n.key, genesis)),
+			grpc.WithChainStreamInterceptor(api.ClientStreamAuth(n.key, genesis)))
+		return
+	}
+
+	// TLS identifies peers, so messages are not signed
+	n.connPool.creds = func(id hash.Peer) (credentials.TransportCredentials, error) {
+		return api.ClientTLS(n.key, genesis, id)
+	}
 	n.connPool.opts = append(n.connPool.opts,
-		grpc.WithInsecure(),
-		grpc.WithUnaryInterceptor(api.ClientAuth(n.key, genesis)))
+		grpc.WithChainUnaryInterceptor(api.ClientTLSAuth()),
+		grpc.WithChainStreamInterceptor(api.ClientStreamTLSAuth()))
 }
 
 func (n *Node) stopClient() {
@@ -76,7 +97,7 @@ func (n *Node) ConnectTo(peer *Peer) (client api.NodeClient, free func(), fail f
 	addr := n.NetAddrOf(peer.Host)
 	n.Debugf("connect to %s", addr)
 
-	c, err := n.connPool.Get(addr)
+	c, err := n.connPool.Get(addr, peer.ID)
 	if err != nil {
 		err = errors.Wrapf(err, "connect to: %s", addr)
 		n.Warn(err)
@@ -94,28 +115,108 @@ func (n *Node) ConnectTo(peer *Peer) (client api.NodeClient, free func(), fail f
 		n.connPool.Release(c, count, err)
 	}
 
-	client = api.NewNodeClient(c.ClientConn)
+	hs, err := n.handshakeOnce(c, peer)
+	if err != nil {
+		fail(err)
+		err = errors.Wrapf(err, "handshake with: %s", addr)
+		n.Warn(err)
+		return
+	}
+
+	client = &peerClient{
+		NodeClient: api.NewNodeClient(c.ClientConn),
+		hs:         hs,
+	}
 
 	return
 }
 
+// handshakeOnce negotiates protocol once per connection.
+// Lock is not held during the request, so concurrent negotiations are possible
+// and the first result is kept.
+func (n *Node) handshakeOnce(c *connection, peer *Peer) (*handshake, error) {
+	c.Lock()
+	hs := c.hs
+	c.Unlock()
+
+	if hs != nil {
+		return hs, nil
+	}
+
+	hs, err := n.handshake(api.NewNodeClient(c.ClientConn))
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.hs == nil {
+		c.hs = hs
+	}
+	return c.hs, nil
+}
+
+// peerClient is a client of the peer with negotiated protocol (see supports).
+type peerClient struct {
+	api.NodeClient
+	hs *handshake
+}
+
+// compressedMethods are methods with heavy responses.
+var compressedMethods = map[string]bool{
+	"/api.Node/SyncEvents":    true,
+	"/api.Node/GetStateNodes": true,
+	"/api.Node/StreamEvents":  true,
+}
+
+// unaryInterceptor addresses requests to the peer (see api.WithServerID).
+// Unknown peer (empty ID) may be asked for its info only.
+func (c *connection) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(api.WithServerID(ctx, c.id), method, req, reply, cc, c.compressed(method, opts)...)
+}
+
+// streamInterceptor addresses streams to the peer (see api.WithServerID).
+func (c *connection) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(api.WithServerID(ctx, c.id), desc, cc, method, c.compressed(method, opts)...)
+}
+
+// compressed adds gzip option to heavy method if peer supports it.
+func (c *connection) compressed(method string, opts []grpc.CallOption) []grpc.CallOption {
+	if !compressedMethods[method] {
+		return opts
+	}
+
+	c.Lock()
+	hs := c.hs
+	c.Unlock()
+
+	if hs == nil || !hs.caps[capGzip] {
+		return opts
+	}
+	return append(opts, grpc.UseCompressor(gzip.Name))
+}
+
 /*
  * connectionPool utils:
  */
 
-func (cc *connPool) Get(addr string) (*connection, error) {
+// Get returns connection to the peer at the address.
+// Empty peer ID is for unknown peer (see api.ClientTLS).
+func (cc *connPool) Get(addr string, id hash.Peer) (*connection, error) {
 	cc.Lock()
 	defer cc.Unlock()
 
-	conn := cc.cache[addr]
+	key := connKey(addr, id)
+	conn := cc.cache[key]
 	if conn == nil {
 		// make new
 		var err error
-		conn, err = cc.newConn(addr)
+		conn, err = cc.newConn(addr, id)
 		if err != nil {
 			return nil, err
 		}
-		cc.cache[addr] = conn
+		cc.cache[key] = conn
 
 		if len(cc.cache) >= cc.size {
 			go cc.Clean()
@@ -136,9 +237,9 @@ func (cc *connPool) Release(c *connection, count bool, err error) {
 	}
 
 	// try to close if error now or before
-	if cached := cc.cache[c.addr]; err != nil || c != cached {
+	if cached := cc.cache[c.key]; err != nil || c != cached {
 		if c == cached {
-			delete(cc.cache, c.addr)
+			delete(cc.cache, c.key)
 		}
 		if c.used < 1 {
 			_ = c.Close()
@@ -163,26 +264,51 @@ func (cc *connPool) Clean() {
 
 	for _, c := range old {
 		_ = c.Close()
-		if cached := cc.cache[c.addr]; c == cached {
-			delete(cc.cache, c.addr)
+		if cached := cc.cache[c.key]; c == cached {
+			delete(cc.cache, c.key)
 		}
 	}
 }
 
-func (cc *connPool) newConn(addr string) (*connection, error) {
+func (cc *connPool) newConn(addr string, id hash.Peer) (*connection, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), cc.connectTimeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, addr, append(cc.opts, grpc.WithBlock())...)
+	c := &connection{
+		key:     connKey(addr, id),
+		addr:    addr,
+		id:      id,
+		created: time.Now(),
+	}
+
+	// connection interceptors are the first to address requests before auth
+	opts := make([]grpc.DialOption, 0, len(cc.opts)+4)
+	opts = append(opts,
+		grpc.WithChainUnaryInterceptor(c.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(c.streamInterceptor))
+	opts = append(opts, cc.opts...)
+	opts = append(opts, grpc.WithBlock())
+	if cc.creds != nil {
+		creds, err := cc.creds(id)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+
+	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return nil, err
 	}
+	c.ClientConn = conn
+
+	return c, nil
+}
 
-	return &connection{
-		ClientConn: conn,
-		addr:       addr,
-		created:    time.Now(),
-	}, nil
+// connKey makes key of connection to the peer at the address.
+// Connections are not shared between peers, as TLS checks the peer ID.
+func connKey(addr string, id hash.Peer) string {
+	return id.Hex() + "@" + addr
 }
 
 /*
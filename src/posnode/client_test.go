@@ -75,3 +75,18 @@ func TestClient(t *testing.T) {
 
 	// TODO: test the all situations.
 }
+
+func TestClientCompression(t *testing.T) {
+	assertar := assert.New(t)
+
+	c := &connection{}
+	assertar.Empty(c.compressed("/api.Node/SyncEvents", nil), "before handshake")
+
+	c.hs = &handshake{caps: map[string]bool{capGzip: true}}
+	assertar.Len(c.compressed("/api.Node/SyncEvents", nil), 1)
+	assertar.Len(c.compressed("/api.Node/StreamEvents", nil), 1)
+	assertar.Empty(c.compressed("/api.Node/GetPeerInfo", nil), "light method")
+
+	c.hs = &handshake{caps: map[string]bool{}}
+	assertar.Empty(c.compressed("/api.Node/SyncEvents", nil), "peer without gzip")
+}
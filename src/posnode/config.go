@@ -4,6 +4,8 @@ import (
 	"net"
 	"strconv"
 	"time"
+
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
 )
 
 // Config is a set of nodes params.
@@ -12,13 +14,35 @@ type Config struct {
 	Port              int // default service port
 
 	GossipThreads    int           // count of gossiping goroutines
-	EmitInterval     time.Duration // event emission interval
+	PushGossip       bool          // announce new events to peers instead of waiting for their pull
+	PushFanout       int           // count of peers to announce
+	EmitInterval     time.Duration // event emission interval of node with nothing to say
 	DiscoveryTimeout time.Duration // how often discovery should try to request
 
+	MinEmitInterval time.Duration // min interval between self events
+	MaxEmitInterval time.Duration // max emission interval after back-off of idle node
+
 	ConnectTimeout time.Duration // how long dialer will for connection to be established
 	ClientTimeout  time.Duration // how long will gRPC client will wait for response
+	TLS            bool          // use TLS with certificates of node keys instead of signing of each message
 
 	TopPeersCount int // peers hot cache size
+
+	BanThreshold  float64       // peer is banned if its score falls below
+	BanTimeout    time.Duration // the first ban period, it doubles for the next bans
+	ScoreHalfLife time.Duration // how fast peer score decays to zero
+
+	SyncBatchBytes uint64 // max size of events batch per sync
+
+	MempoolSize      int           // max count of pending external txns
+	MempoolPerSender int           // max count of pending external txns taken by the same peer
+	MempoolTTL       time.Duration // how long txn may be pending
+	MempoolInternal  int           // max count of pending self internal txns
+
+	ServiceLimits api.Limits // quotas of service resources for peers
+
+	FastSync          bool   // start from the last checkpoint instead of genesis
+	FastSyncMinHeight uint64 // min head height of peer to fast sync with, else full sync
 }
 
 // DefaultConfig returns default config.
@@ -28,13 +52,39 @@ func DefaultConfig() *Config {
 		Port:              55555,
 
 		GossipThreads:    4,
+		PushFanout:       3,
 		EmitInterval:     10 * time.Second,
 		DiscoveryTimeout: 5 * time.Minute,
 
+		MinEmitInterval: 1 * time.Second,
+		MaxEmitInterval: 1 * time.Minute,
+
 		ConnectTimeout: 15 * time.Second,
 		ClientTimeout:  15 * time.Second,
 
 		TopPeersCount: 10,
+
+		BanThreshold:  -100,
+		BanTimeout:    10 * time.Minute,
+		ScoreHalfLife: 10 * time.Minute,
+
+		SyncBatchBytes: 4 * 1024 * 1024,
+
+		MempoolSize:      10000,
+		MempoolPerSender: 2000,
+		MempoolTTL:       10 * time.Minute,
+		MempoolInternal:  2000,
+
+		ServiceLimits: api.Limits{
+			PeerRate:       100,
+			TotalRate:      1000,
+			PeerBandwidth:  8 * 1024 * 1024,
+			TotalBandwidth: 64 * 1024 * 1024,
+			MaxStreams:     64,
+			HostRate:       200,
+		},
+
+		FastSyncMinHeight: 256,
 	}
 }
 
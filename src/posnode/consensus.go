@@ -2,6 +2,9 @@ package posnode
 
 import (
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
 )
 
 // Consensus is a consensus interface.
@@ -10,6 +13,30 @@ type Consensus interface {
 	PushEvent(hash.Event)
 	// StakeOf returns stake of peer.
 	StakeOf(hash.Peer) uint64
+	// NonceOf returns next expected txn index of peer.
+	NonceOf(hash.Peer) uint64
 	// GetGenesisHash returns hash of genesis poset works with.
 	GetGenesisHash() hash.Hash
+	// GetRules returns consensus rules.
+	GetRules() posposet.ConsensusRules
+	// CurrentEpoch returns number of the current epoch.
+	CurrentEpoch() uint64
+	// TotalStake returns total stake of the current epoch validators.
+	TotalStake() uint64
+	// LastBlockN returns number of the last finalized block.
+	LastBlockN() uint64
+	// GetBlock returns finalized block by number.
+	GetBlock(uint64) *inter.Block
+	// LowestBlockN returns number of the lowest block which events are retained.
+	LowestBlockN() uint64
+	// GetCheckpoint returns the last certified block with proofs of epochs validators.
+	GetCheckpoint() *posposet.Checkpoint
+	// GetStateNode returns node of balances trie.
+	GetStateNode(hash.Hash) []byte
+	// StateSync makes scheduler to download balances trie of checkpoint.
+	StateSync(*posposet.Checkpoint) (*trie.Sync, error)
+	// CommitStateSync writes downloaded nodes of balances trie.
+	CommitStateSync(*trie.Sync) error
+	// ApplyCheckpoint starts consensus from the checkpoint.
+	ApplyCheckpoint(*posposet.Checkpoint) error
 }
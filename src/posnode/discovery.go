@@ -3,6 +3,7 @@ package posnode
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -15,6 +16,11 @@ import (
 
 const (
 	discoveryIdle = time.Second * 5
+
+	// maxPeersExchange is a max count of peers per GetPeers response.
+	maxPeersExchange = 32
+	// peerReachable is how long peer is reachable after successful connection.
+	peerReachable = time.Hour
 )
 
 type (
@@ -34,8 +40,10 @@ type (
 )
 
 // StartDiscovery starts single thread network discovery.
+// Each discovered peer is crawled for its peers.
 // If there are no tasks for the discovery of unknown peers,
-// after idle time will try to discover one of builtin peers.
+// after idle time will try to discover one of builtin peers
+// or one of known addresses if there are no builtin peers.
 func (n *Node) StartDiscovery() {
 	if n.discovery.done != nil {
 		return
@@ -56,7 +64,12 @@ func (n *Node) StartDiscovery() {
 			case task := <-n.discovery.tasks:
 				n.AskPeerInfo(task.host, task.unknown)
 			case <-time.After(discoveryIdle):
-				if host := n.NextBuiltInPeer(); host != "" {
+				host := n.NextBuiltInPeer()
+				if host == "" {
+					// rejoin the network by address book
+					host = n.randomPeerAddr()
+				}
+				if host != "" {
 					n.AskPeerInfo(host, nil)
 				}
 			case <-done:
@@ -130,22 +143,154 @@ func (n *Node) AskPeerInfo(host string, id *hash.Peer) {
 		return
 	}
 
-	if hash.PeerOfPubkeyBytes(info.PubKey) != hash.HexToPeer(info.ID) {
-		n.ConnectFail(peer, fmt.Errorf("bad PeerInfo response"))
+	if err := n.setPeerRecord(info); err != nil {
+		n.ConnectFail(peer, fmt.Errorf("bad PeerInfo response: %s", err))
+		n.ScorePeer(source, scoreBadResponse)
 		return
 	}
 
+	// relayed record addresses are not stored until the peer itself is discovered
 	if id != nil && source != *id {
 		n.ConnectOK(peer)
-		n.AskPeerInfo(info.Host, nil)
+		if info.Host != "" {
+			n.AskPeerInfo(info.Host, nil)
+		}
 		return
 	}
 
-	info.Host = host
 	peer = WireToPeer(info)
-	n.store.SetWirePeer(peer.ID, info)
-	n.Debugf("discovered new peer %s with host %s", info.ID, info.Host)
+	n.addRecordAddrs(info, source)
+	n.AddPeerAddr(peer.ID, host, source)
+	n.Debugf("discovered new peer %s with host %s", info.ID, host)
+	peer.Host = host
 	n.ConnectOK(peer)
+
+	n.crawlPeers(peer)
+}
+
+// crawlPeers asks peer for its peers and queues them for discovery.
+// Each peer is crawled once per discovery timeout.
+// Records of peers are stored, but their addresses are not until discovered.
+func (n *Node) crawlPeers(peer *Peer) {
+	if !n.readyForCrawl(peer.ID) {
+		return
+	}
+
+	client, free, fail, err := n.ConnectTo(peer)
+	if err != nil {
+		n.ConnectFail(peer, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	resp, err := client.GetPeers(ctx, &api.PeersRequest{Max: maxPeersExchange})
+	if err != nil {
+		n.ConnectFail(peer, err)
+		fail(err)
+		return
+	}
+	free()
+
+	if len(resp.Peers) > maxPeersExchange {
+		n.ScorePeer(peer.ID, scoreBadResponse)
+		resp.Peers = resp.Peers[:maxPeersExchange]
+	}
+
+	for i, info := range resp.Peers {
+		if err := n.setPeerRecord(info); err != nil {
+			n.ScorePeer(peer.ID, scoreBadResponse)
+			continue
+		}
+		id := hash.HexToPeer(info.ID)
+		if id == n.ID {
+			continue
+		}
+
+		// seen address is preferred, record host may be empty
+		host := info.Host
+		if i < len(resp.Hosts) && resp.Hosts[i] != "" {
+			host = resp.Hosts[i]
+		}
+		if host == "" {
+			continue
+		}
+
+		// addresses are stored on discovery of the peer itself
+		n.CheckPeerIsKnown(host, &id)
+	}
+}
+
+// addRecordAddrs puts hosts of peer record into address book.
+func (n *Node) addRecordAddrs(info *api.PeerInfo, source hash.Peer) {
+	id := hash.HexToPeer(info.ID)
+	n.AddPeerAddr(id, info.Host, source)
+	for _, host := range info.Addrs {
+		n.AddPeerAddr(id, host, source)
+	}
+}
+
+// reachablePeers returns random sample of top peers with recently seen addresses.
+// Records are self-signed by peers, so they are relayed as is
+// and the seen addresses are attached as hosts.
+func (n *Node) reachablePeers(max int, except hash.Peer) *api.PeerList {
+	ids := n.peers.Snapshot()
+	rand.Shuffle(len(ids), func(i, j int) {
+		ids[i], ids[j] = ids[j], ids[i]
+	})
+
+	since := time.Now().Add(-peerReachable)
+	res := &api.PeerList{
+		Peers: make([]*api.PeerInfo, 0, max),
+		Hosts: make([]string, 0, max),
+	}
+	for _, id := range ids {
+		if len(res.Peers) >= max {
+			break
+		}
+		if id == except || n.PeerBanned(id) {
+			continue
+		}
+
+		host := n.reachablePeerAddr(id, since)
+		if host == "" {
+			continue
+		}
+		// not self-signed records (bootstrap) are not for relay
+		info := n.store.GetWirePeer(id)
+		if verifyRecord(info) != nil {
+			continue
+		}
+
+		res.Peers = append(res.Peers, info)
+		res.Hosts = append(res.Hosts, host)
+	}
+
+	return res
+}
+
+// readyForCrawl returns true and marks peer as crawled if it was not crawled recently.
+func (n *Node) readyForCrawl(id hash.Peer) bool {
+	n.peers.Lock()
+	defer n.peers.Unlock()
+
+	if n.peers.ids == nil {
+		return false
+	}
+
+	attr := n.peers.attrByID(id)
+	if attr == nil {
+		return false
+	}
+
+	now := time.Now()
+	if now.Sub(attr.Crawled) < n.conf.DiscoveryTimeout {
+		return false
+	}
+	attr.Crawled = now
+
+	return true
 }
 
 // requestPeerInfo does GetPeerInfo request.
@@ -163,12 +308,11 @@ func (n *Node) requestPeerInfo(client api.NodeClient, id *hash.Peer) (
 	id, ctx = api.ServerPeerID(ctx)
 
 	info, err = client.GetPeerInfo(ctx, &req)
+	source = *id
 	if err == nil {
 		return
 	}
 
-	source = *id
-
 	if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
 		info, err = nil, nil
 	}
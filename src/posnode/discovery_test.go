@@ -99,6 +99,9 @@ func TestDiscoveryHost(t *testing.T) {
 	assertar.Equal(
 		node2.ID,
 		node1.peers.Snapshot()[0])
+	if book := store1.GetPeerAddrs(node2.ID); assertar.NotNil(book) && assertar.NotEmpty(book.Addrs) {
+		assertar.Equal(node2.ID.Hex(), book.Addrs[0].Source, "host is told by peer itself")
+	}
 
 	select {
 	case <-nodeDiscoveryFinish(node2):
@@ -109,6 +112,58 @@ func TestDiscoveryHost(t *testing.T) {
 		node2.peers.Snapshot()[0])
 }
 
+func TestDiscoveryCrawl(t *testing.T) {
+	defer leaktest.CheckTimeout(t, time.Second)()
+
+	assertar := assert.New(t)
+
+	// node 1 knows node 2
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, nil)
+	node1.StartService()
+	defer node1.Stop()
+
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, nil)
+	node2.StartService()
+	defer node2.Stop()
+
+	node1.initPeers()
+	node1.AskPeerInfo(node2.Host(), nil)
+
+	// node 3 knows node 1 only
+	store3 := NewMemStore()
+	node3 := NewForTests("node3", store3, nil)
+	node3.StartService()
+	node3.StartDiscovery()
+	defer node3.Stop()
+
+	node3.AskPeerInfo(node1.Host(), nil)
+	assertar.NotNil(store3.GetPeer(node1.ID))
+
+	// node 2 is found by crawling node 1
+	deadline := time.Now().Add(time.Second)
+	for len(node3.PeerAddrs(node2.ID)) < 1 && time.Now().Before(deadline) {
+		time.Sleep(time.Second / 20)
+	}
+	assertar.Equal(node2.AsPeer(), store3.GetPeer(node2.ID))
+	assertar.Contains(node3.PeerAddrs(node2.ID), node2.Host())
+
+	t.Run("reachable only", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		unreachable := FakePeer("unreachable")
+		store1.BootstrapPeers(unreachable)
+
+		peers := node1.reachablePeers(maxPeersExchange, node3.ID)
+		if assertar.Len(peers.Peers, 1) && assertar.Len(peers.Hosts, 1) {
+			assertar.Equal(node2.ID.Hex(), peers.Peers[0].ID)
+			assertar.Equal(node2.Host(), peers.Hosts[0])
+		}
+		assertar.Empty(node1.reachablePeers(maxPeersExchange, node2.ID).Peers)
+	})
+}
+
 /*
  * Utils:
  */
@@ -0,0 +1,200 @@
+package posnode
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// Reasons of self event emission, they are keys of EmissionStats() too.
+const (
+	emitByTxns  = "txns"  // there are pending txns
+	emitByRoots = "roots" // events of others make a new root possible
+	emitByIdle  = "idle"  // nothing to say, but interval is over
+	emitTotal   = "emitted"
+)
+
+// minEmitTick is the shortest period of emission reasons check.
+const minEmitTick = 10 * time.Millisecond
+
+// emission is an adaptive policy of self events emission.
+type emission struct {
+	last     time.Time
+	interval time.Duration // idle interval, it grows while node has nothing to say
+
+	epoch  uint64
+	total  uint64                 // total stake of the epoch validators, zero if unknown
+	stakes map[hash.Peer]uint64   // stakes of known creators of the epoch
+	fresh  map[hash.Peer]struct{} // creators of new events since the last self event
+
+	counts map[string]uint64
+
+	sync.Mutex
+}
+
+// EmissionStats returns counts of emitted self events by reasons.
+func (n *Node) EmissionStats() map[string]uint64 {
+	n.emission.Lock()
+	defer n.emission.Unlock()
+
+	res := map[string]uint64{
+		emitTotal:   0,
+		emitByTxns:  0,
+		emitByRoots: 0,
+		emitByIdle:  0,
+	}
+	for reason, count := range n.emission.counts {
+		res[reason] = count
+	}
+
+	return res
+}
+
+// emissionReason returns why to emit self event now or empty string to wait.
+func (n *Node) emissionReason(now time.Time) string {
+	// NOTE: it locks emitter and mempool, so call it before emission lock
+	pending := n.hasPendingTxns(now)
+
+	n.emission.Lock()
+	defer n.emission.Unlock()
+
+	elapsed := now.Sub(n.emission.last)
+	if elapsed < n.conf.MinEmitInterval {
+		return ""
+	}
+
+	if pending {
+		return emitByTxns
+	}
+
+	if n.isRootPossible() {
+		return emitByRoots
+	}
+
+	if elapsed >= n.idleInterval() {
+		return emitByIdle
+	}
+
+	return ""
+}
+
+// emitBy emits self event and adapts idle interval to the reason.
+func (n *Node) emitBy(reason string) {
+	if n.EmitEvent() == nil {
+		return
+	}
+
+	n.emission.Lock()
+	defer n.emission.Unlock()
+
+	n.emission.counts[reason]++
+
+	if reason == emitByIdle {
+		n.emission.interval = n.idleInterval() * 2
+		if n.emission.interval > n.conf.MaxEmitInterval {
+			n.emission.interval = n.conf.MaxEmitInterval
+		}
+	} else {
+		n.emission.interval = n.conf.EmitInterval
+	}
+}
+
+// onSelfEmitted resets the policy state after self event.
+func (n *Node) onSelfEmitted() {
+	n.emission.Lock()
+	defer n.emission.Unlock()
+
+	if n.emission.counts == nil {
+		n.emission.counts = make(map[string]uint64)
+	}
+
+	n.emission.last = time.Now()
+	n.emission.fresh = nil
+	n.emission.counts[emitTotal]++
+}
+
+// onEventOf notes new event of creator with the stake in the epoch of the total stake.
+// Stakes of the previous epoch creators are forgotten, so left validators are not counted.
+func (n *Node) onEventOf(creator hash.Peer, stake, epoch, total uint64) {
+	n.emission.Lock()
+	defer n.emission.Unlock()
+
+	if n.emission.epoch != epoch {
+		n.emission.epoch = epoch
+		n.emission.stakes = nil
+	}
+	n.emission.total = total
+
+	if n.emission.stakes == nil {
+		n.emission.stakes = make(map[hash.Peer]uint64)
+	}
+	n.emission.stakes[creator] = stake
+
+	if creator == n.ID {
+		return
+	}
+
+	if n.emission.fresh == nil {
+		n.emission.fresh = make(map[hash.Peer]struct{})
+	}
+	n.emission.fresh[creator] = struct{}{}
+}
+
+/*
+ * Utils:
+ */
+
+// hasPendingTxns returns true if there is something to include into self event.
+func (n *Node) hasPendingTxns(now time.Time) bool {
+	n.emitter.RLock()
+	internals := len(n.emitter.internalTxns)
+	n.emitter.RUnlock()
+
+	n.forks.Lock()
+	proofs := len(n.forks.pending)
+	n.forks.Unlock()
+
+	return internals > 0 || proofs > 0 || n.hasReadyTxns(now)
+}
+
+// isRootPossible returns true if self event can see new events of more than 2/3 stake.
+// Stake of validators which are not seen yet is counted too, if the total is known.
+// Call it under emission lock.
+func (n *Node) isRootPossible() bool {
+	if len(n.emission.fresh) < 1 {
+		return false
+	}
+
+	var fresh, known uint64
+	for creator, stake := range n.emission.stakes {
+		known += stake
+		if _, ok := n.emission.fresh[creator]; ok || creator == n.ID {
+			fresh += stake
+		}
+	}
+
+	total := n.emission.total
+	if total == 0 {
+		total = known
+	}
+
+	return fresh*3 > total*2
+}
+
+// emitTick returns period of emission reasons check.
+func (n *Node) emitTick() time.Duration {
+	if n.conf.MinEmitInterval < minEmitTick {
+		return minEmitTick
+	}
+	return n.conf.MinEmitInterval
+}
+
+// idleInterval returns current interval of emission without reasons.
+// Call it under emission lock.
+func (n *Node) idleInterval() time.Duration {
+	if n.emission.interval == 0 {
+		return n.conf.EmitInterval
+	}
+	return n.emission.interval
+}
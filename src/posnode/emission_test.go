@@ -0,0 +1,116 @@
+package posnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestEmissionPolicy(t *testing.T) {
+	node := NewForTests("emitter", NewMemStore(), nil)
+	node.initParents()
+	defer node.Stop()
+
+	node.conf.MinEmitInterval = time.Second
+	node.conf.EmitInterval = 10 * time.Second
+	node.conf.MaxEmitInterval = 30 * time.Second
+
+	// pretends time passed since the last self event
+	wait := func(d time.Duration) {
+		node.emission.Lock()
+		node.emission.last = node.emission.last.Add(-d)
+		node.emission.Unlock()
+	}
+
+	t.Run("idle", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		assertar.Equal(emitByIdle, node.emissionReason(time.Now()))
+		node.emitBy(emitByIdle)
+		assertar.Equal("", node.emissionReason(time.Now()))
+
+		wait(node.conf.EmitInterval)
+		assertar.Equal("", node.emissionReason(time.Now()), "backed off")
+		wait(node.conf.EmitInterval)
+		assertar.Equal(emitByIdle, node.emissionReason(time.Now()))
+		node.emitBy(emitByIdle)
+	})
+
+	t.Run("txns", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		if !assertar.NoError(node.AddExternalTxn([]byte("txn"))) {
+			return
+		}
+		assertar.Equal("", node.emissionReason(time.Now()), "too soon")
+
+		wait(node.conf.MinEmitInterval)
+		assertar.Equal(emitByTxns, node.emissionReason(time.Now()))
+		node.emitBy(emitByTxns)
+		assertar.Equal(0, node.PendingTxnsCount())
+	})
+
+	t.Run("roots", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		a, b := hash.FakePeer(), hash.FakePeer()
+		node.onEventOf(a, 1, 0, 0)
+		node.onEventOf(b, 1, 0, 0)
+		node.emitBy(emitByIdle)
+
+		wait(node.conf.MinEmitInterval)
+		node.onEventOf(a, 1, 0, 0)
+		assertar.Equal("", node.emissionReason(time.Now()), "2/3 of stake is not enough")
+
+		node.onEventOf(b, 1, 0, 0)
+		assertar.Equal(emitByRoots, node.emissionReason(time.Now()))
+
+		node.onEventOf(b, 1, 0, 5)
+		assertar.Equal("", node.emissionReason(time.Now()), "validators not seen yet are counted")
+	})
+
+	t.Run("epoch", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		c := hash.FakePeer()
+		node.onEventOf(c, 1, 1, 0)
+
+		node.emission.Lock()
+		defer node.emission.Unlock()
+		assertar.Equal(map[hash.Peer]uint64{c: 1}, node.emission.stakes, "validators of the previous epoch are forgotten")
+	})
+
+	t.Run("back-off", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		for i := 0; i < 5; i++ {
+			node.emitBy(emitByIdle)
+		}
+		assertar.Equal(node.conf.MaxEmitInterval, node.emission.interval)
+
+		node.emitBy(emitByRoots)
+		assertar.Equal(node.conf.EmitInterval, node.emission.interval)
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		assertar.Equal(map[string]uint64{
+			emitTotal:   10,
+			emitByIdle:  8,
+			emitByTxns:  1,
+			emitByRoots: 1,
+		}, node.EmissionStats())
+	})
+
+	t.Run("tick", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		assertar.Equal(node.conf.MinEmitInterval, node.emitTick())
+		node.conf.MinEmitInterval = 0
+		assertar.Equal(minEmitTick, node.emitTick(), "ticker panics on zero")
+	})
+}
@@ -2,17 +2,23 @@ package posnode
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/ordering"
 )
 
-// emitter creates events from external transactions.
+// emitter creates events from pending transactions.
 type emitter struct {
 	internalTxns map[hash.Transaction]*inter.InternalTransaction
-	externalTxns [][]byte
 	done         chan struct{}
 
 	sync.RWMutex
@@ -28,11 +34,14 @@ func (n *Node) StartEventEmission() {
 	n.initParents()
 
 	go func(done chan struct{}) {
-		ticker := time.NewTicker(n.conf.EmitInterval)
+		ticker := time.NewTicker(n.emitTick())
+		defer ticker.Stop()
 		for {
 			select {
-			case <-ticker.C:
-				n.EmitEvent()
+			case now := <-ticker.C:
+				if reason := n.emissionReason(now); reason != "" {
+					n.emitBy(reason)
+				}
 			case <-done:
 				return
 			}
@@ -63,16 +72,25 @@ func (n *Node) internalTxnMempool(idx hash.Transaction) *inter.InternalTransacti
 
 // AddInternalTxn takes internal transaction for new event.
 func (n *Node) AddInternalTxn(tx inter.InternalTransaction) (hash.Transaction, error) {
-	if tx.Receiver == n.ID {
-		return hash.Transaction{}, fmt.Errorf("can not transfer to yourself")
-	}
+	switch tx.Kind {
+	case inter.StakeTransfer:
+		if tx.Receiver == n.ID {
+			return hash.Transaction{}, fmt.Errorf("can not transfer to yourself")
+		}
 
-	if tx.Amount < 1 {
-		return hash.Transaction{}, fmt.Errorf("can not transfer zero amount")
-	}
+		if tx.Amount < 1 {
+			return hash.Transaction{}, fmt.Errorf("can not transfer zero amount")
+		}
 
-	if balance := n.consensus.StakeOf(n.ID); tx.Amount > balance {
-		return hash.Transaction{}, fmt.Errorf("insufficient funds %d to transfer %d", balance, tx.Amount)
+		if balance := n.consensus.StakeOf(n.ID); tx.Amount > balance {
+			return hash.Transaction{}, fmt.Errorf("insufficient funds %d to transfer %d", balance, tx.Amount)
+		}
+	case inter.ValidatorRegistration, inter.ValidatorUnregistration:
+		if tx.Amount != 0 || !tx.Receiver.IsEmpty() {
+			return hash.Transaction{}, fmt.Errorf("registration txn can not transfer stake")
+		}
+	default:
+		return hash.Transaction{}, fmt.Errorf("unknown txn kind %d", tx.Kind)
 	}
 
 	idx := inter.TransactionHashOf(n.ID, tx.Index)
@@ -92,23 +110,46 @@ func (n *Node) AddInternalTxn(tx inter.InternalTransaction) (hash.Transaction, e
 		return idx, fmt.Errorf("the same txn already exists in event %d of %s", e.Index, e.Creator.String())
 	}
 
+	if len(n.emitter.internalTxns) >= n.conf.MempoolInternal {
+		return idx, fmt.Errorf("too many pending internal txns, max is %d", n.conf.MempoolInternal)
+	}
+
+	nonce := n.nextNonce()
+	if tx.Index < nonce {
+		return idx, fmt.Errorf("txn nonce %d is stale, next nonce is %d", tx.Index, nonce)
+	}
+	if tx.Index > nonce {
+		return idx, fmt.Errorf("txn nonce %d is too far ahead, next nonce is %d", tx.Index, nonce)
+	}
+
 	n.emitter.internalTxns[idx] = &tx
 
 	return idx, nil
 }
 
-// AddExternalTxn takes external transaction for new event.
-func (n *Node) AddExternalTxn(tx []byte) {
-	n.emitter.Lock()
-	defer n.emitter.Unlock()
-	// TODO: copy tx val?
-	n.emitter.externalTxns = append(n.emitter.externalTxns, tx)
+// nextNonce returns index for the next self txn.
+// It skips nonces of txns which are in mempool or in events but not applied by consensus yet.
+// Call it under emitter lock.
+func (n *Node) nextNonce() uint64 {
+	nonce := n.consensus.NonceOf(n.ID)
+	for {
+		idx := inter.TransactionHashOf(n.ID, nonce)
+		if n.emitter.internalTxns[idx] == nil && n.store.GetTxnsEvent(idx) == nil {
+			return nonce
+		}
+		nonce++
+	}
 }
 
 // EmitEvent takes all transactions from buffer builds event,
 // connects it with given amount of parents, sign and put it into the storage.
 // It returns emitted event for test purpose.
 func (n *Node) EmitEvent() *inter.Event {
+	// event sequence is unknown before fast sync
+	if n.isFastSyncPending() {
+		return nil
+	}
+
 	n.emitter.Lock()
 	defer n.emitter.Unlock()
 
@@ -120,6 +161,8 @@ func (n *Node) EmitEvent() *inter.Event {
 		maxLamportTime inter.Timestamp
 		internalTxns   []*inter.InternalTransaction
 		externalTxns   [][]byte
+		limits         = n.eventLimits()
+		creators       = map[hash.Peer]struct{}{n.ID: {}}
 	)
 
 	prev := n.LastEventOf(n.ID)
@@ -132,7 +175,12 @@ func (n *Node) EmitEvent() *inter.Event {
 		parents.Add(hash.ZeroEvent)
 	}
 
-	for i := 1; i < n.conf.EventParentsCount; i++ {
+	parentsCount := n.conf.EventParentsCount
+	if max := limits.MaxParents; max > 0 && parentsCount > max {
+		parentsCount = max
+	}
+
+	for i := 1; i < parentsCount; i++ {
 		p := n.popBestParent()
 		if p == nil {
 			break
@@ -142,36 +190,130 @@ func (n *Node) EmitEvent() *inter.Event {
 		}
 
 		parent := n.store.GetEvent(*p)
+		if parent == nil {
+			// pruned already
+			delete(parents, *p)
+			continue
+		}
+		// fork branches of cheater can not be parents both
+		if _, ok := creators[parent.Creator]; ok {
+			delete(parents, *p)
+			continue
+		}
+		creators[parent.Creator] = struct{}{}
 		if maxLamportTime < parent.LamportTime {
 			maxLamportTime = parent.LamportTime
 		}
 	}
 
-	// transactions buffer swap
+	event := &inter.Event{
+		Index:       index,
+		Creator:     n.ID,
+		Parents:     parents,
+		LamportTime: maxLamportTime + 1,
+		Sign:        maxSign,
+	}
+
+	// the rest of proofs, signs and txns waits for the next event
+	budget := newEventBudget(limits, event.Size())
+
+	event.ForkProofs = n.popForkProofs(budget)
+	event.BlockSigns = n.popBlockSigns(budget)
+
 	internalTxns = make([]*inter.InternalTransaction, 0, len(n.emitter.internalTxns))
-	for idx, txn := range n.emitter.internalTxns {
+	for _, txn := range n.emitter.internalTxns {
+		internalTxns = append(internalTxns, txn)
+	}
+	// consensus applies txns in nonce order only
+	sort.Slice(internalTxns, func(i, j int) bool {
+		return internalTxns[i].Index < internalTxns[j].Index
+	})
+	for i, txn := range internalTxns {
+		if !budget.Take(proto.Size(txn.ToWire())) {
+			internalTxns = internalTxns[:i]
+			break
+		}
+		idx := inter.TransactionHashOf(n.ID, txn.Index)
 		n.Debugf("event internal tx [%s] amount: %d from [%s] to [%s]",
 			idx.Hex(), txn.Amount, n.ID.Hex(), txn.Receiver.Hex())
-		internalTxns = append(internalTxns, txn)
+		delete(n.emitter.internalTxns, idx)
 	}
-	n.emitter.internalTxns = nil
 
-	externalTxns, n.emitter.externalTxns = n.emitter.externalTxns, nil
+	externalTxns = n.popReadyTxns(budget)
 
-	event := &inter.Event{
-		Index:                index,
-		Creator:              n.ID,
-		Parents:              parents,
-		LamportTime:          maxLamportTime + 1,
-		InternalTransactions: internalTxns,
-		ExternalTransactions: externalTxns,
-	}
+	event.InternalTransactions = internalTxns
+	event.ExternalTransactions = externalTxns
 	if err := event.SignBy(n.key); err != nil {
 		n.Fatal(err)
 	}
 
 	n.onNewEvent(event)
+	n.onSelfEmitted()
 	n.Infof("new event emitted %s", event)
 
 	return event
 }
+
+// eventLimits returns max sizes of event by consensus rules.
+func (n *Node) eventLimits() ordering.Limits {
+	if n.consensus == nil {
+		return ordering.Limits{}
+	}
+	rules := n.consensus.GetRules()
+	return rules.EventLimits()
+}
+
+/*
+ * Utils:
+ */
+
+// maxSign is the longest sign of event, its size is reserved while event is built.
+var maxSign = func() string {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	return crypto.EncodeSignature(max, max)
+}()
+
+// eventBudget is a room left for txns, fork proofs and block signs in event.
+type eventBudget struct {
+	txns  int
+	bytes int
+}
+
+// newEventBudget returns room left in event of size by limits.
+// Zero limits are unlimited.
+func newEventBudget(limits ordering.Limits, size int) *eventBudget {
+	b := &eventBudget{
+		txns:  limits.MaxTxns,
+		bytes: limits.MaxBytes - size,
+	}
+	if limits.MaxTxns < 1 {
+		b.txns = math.MaxInt32
+	}
+	if limits.MaxBytes < 1 {
+		b.bytes = math.MaxInt32
+	}
+	return b
+}
+
+// Take reserves room for txn of size, it returns false if txn does not fit.
+func (b *eventBudget) Take(size int) bool {
+	if b.txns < 1 || !b.TakeBytes(size) {
+		return false
+	}
+
+	b.txns--
+	return true
+}
+
+// TakeBytes reserves room for repeated field item of size (not a txn),
+// it returns false if item does not fit.
+func (b *eventBudget) TakeBytes(size int) bool {
+	// repeated field tag and length
+	size += 1 + proto.SizeVarint(uint64(size))
+	if b.bytes < size {
+		return false
+	}
+
+	b.bytes -= size
+	return true
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 )
 
 func TestAddInternalTxn(t *testing.T) {
@@ -19,6 +20,10 @@ func TestAddInternalTxn(t *testing.T) {
 		StakeOf(gomock.Any()).
 		Return(uint64(2000)).
 		AnyTimes()
+	consensus.EXPECT().
+		NonceOf(gomock.Any()).
+		Return(uint64(0)).
+		AnyTimes()
 
 	node := NewForTests("fake", NewMemStore(), consensus)
 	peer := hash.FakePeer()
@@ -27,7 +32,7 @@ func TestAddInternalTxn(t *testing.T) {
 		assertar := assert.New(t)
 
 		tx := inter.InternalTransaction{
-			Index:    1,
+			Index:    0,
 			Amount:   1000,
 			Receiver: peer,
 		}
@@ -44,7 +49,7 @@ func TestAddInternalTxn(t *testing.T) {
 		assertar := assert.New(t)
 
 		tx := inter.InternalTransaction{
-			Index:    2,
+			Index:    1,
 			Amount:   1000,
 			Receiver: peer,
 		}
@@ -56,6 +61,184 @@ func TestAddInternalTxn(t *testing.T) {
 		// TODO: check when implemented
 		//assert.Equal(expect, h.Hex())
 	})
+
+	t.Run("registration", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		tx := inter.InternalTransaction{
+			Index: 2,
+			Kind:  inter.ValidatorRegistration,
+		}
+		_, err := node.AddInternalTxn(tx)
+		assertar.NoError(err)
+
+		tx = inter.InternalTransaction{
+			Index:    3,
+			Amount:   1000,
+			Receiver: peer,
+			Kind:     inter.ValidatorUnregistration,
+		}
+		_, err = node.AddInternalTxn(tx)
+		assertar.Error(err)
+	})
+
+	t.Run("wrong nonce", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		// txn 3 is in event already, but not applied by consensus yet
+		e := &inter.Event{
+			Index:   1,
+			Creator: node.ID,
+			Parents: hash.NewEvents(hash.ZeroEvent),
+			InternalTransactions: []*inter.InternalTransaction{{
+				Index: 3,
+				Kind:  inter.ValidatorUnregistration,
+			}},
+		}
+		node.store.SetEvent(e)
+		node.store.SetTxnsEvent(e.Hash(), node.ID, e.InternalTransactions...)
+
+		for _, index := range []uint64{0, 3, 5} {
+			tx := inter.InternalTransaction{
+				Index:    index,
+				Amount:   1,
+				Receiver: peer,
+			}
+			_, err := node.AddInternalTxn(tx)
+			assertar.Error(err, "nonce %d", index)
+		}
+
+		tx := inter.InternalTransaction{
+			Index:    4,
+			Amount:   1,
+			Receiver: peer,
+		}
+		_, err := node.AddInternalTxn(tx)
+		assertar.NoError(err)
+	})
+
+	t.Run("emit in nonce order", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		consensus.EXPECT().
+			PushEvent(gomock.Any()).
+			AnyTimes()
+		consensus.EXPECT().
+			LastBlockN().
+			Return(uint64(0)).
+			AnyTimes()
+		consensus.EXPECT().
+			CurrentEpoch().
+			Return(uint64(0)).
+			AnyTimes()
+		consensus.EXPECT().
+			TotalStake().
+			Return(uint64(0)).
+			AnyTimes()
+		consensus.EXPECT().
+			GetRules().
+			Return(posposet.DefaultRules()).
+			AnyTimes()
+
+		node.initParents()
+		e := node.EmitEvent()
+
+		var indexes []uint64
+		for _, tx := range e.InternalTransactions {
+			indexes = append(indexes, tx.Index)
+		}
+		assertar.Equal([]uint64{0, 1, 2, 4}, indexes)
+	})
+}
+
+func TestEmitBlockSigns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	consensus := NewMockConsensus(ctrl)
+	node := NewForTests("signer", NewMemStore(), consensus)
+	node.initParents()
+	defer node.Stop()
+
+	rules := posposet.DefaultRules()
+	rules.MaxEventBytes = 0
+	consensus.EXPECT().
+		CurrentEpoch().
+		Return(uint64(0)).
+		AnyTimes()
+	consensus.EXPECT().
+		TotalStake().
+		Return(uint64(0)).
+		AnyTimes()
+	consensus.EXPECT().
+		GetRules().
+		DoAndReturn(func() posposet.ConsensusRules {
+			return rules
+		}).
+		AnyTimes()
+
+	blocks := make([]*inter.Block, maxBlockSigns+5)
+	for i := range blocks {
+		blocks[i] = inter.NewBlock(uint64(i+1), uint64(i+1), inter.Timestamp(i), hash.FakeHash(), hash.FakeHash(), nil)
+	}
+
+	consensus.EXPECT().
+		StakeOf(node.ID).
+		Return(uint64(1)).
+		AnyTimes()
+	consensus.EXPECT().
+		GetBlock(gomock.Any()).
+		DoAndReturn(func(n uint64) *inter.Block {
+			return blocks[n-1]
+		}).
+		AnyTimes()
+	consensus.EXPECT().
+		PushEvent(gomock.Any()).
+		AnyTimes()
+
+	signed := func(e *inter.Event) (nn []uint64) {
+		for _, s := range e.BlockSigns {
+			if !assert.NoError(t, s.Verify()) {
+				continue
+			}
+			assert.Equal(t, node.ID, s.Signer())
+			assert.Equal(t, blocks[s.BlockN-1].Hash(), s.BlockHash)
+			nn = append(nn, s.BlockN)
+		}
+		return
+	}
+
+	consensus.EXPECT().
+		LastBlockN().
+		Return(uint64(2))
+	e := node.EmitEvent()
+	assert.Equal(t, []uint64{1, 2}, signed(e))
+
+	consensus.EXPECT().
+		LastBlockN().
+		Return(uint64(2))
+	assert.Empty(t, signed(node.EmitEvent()))
+
+	// out of budget, the rest is signed with the next event
+	rules.MaxEventBytes = uint64(e.Size() - 50)
+	consensus.EXPECT().
+		LastBlockN().
+		Return(uint64(4)).
+		Times(2)
+	assert.Equal(t, []uint64{3}, signed(node.EmitEvent()))
+	assert.Equal(t, []uint64{4}, signed(node.EmitEvent()))
+	rules.MaxEventBytes = 0
+
+	// too many blocks, the last ones are signed only
+	last := uint64(len(blocks))
+	consensus.EXPECT().
+		LastBlockN().
+		Return(last)
+	nn := signed(node.EmitEvent())
+	if assert.Len(t, nn, maxBlockSigns) {
+		assert.Equal(t, last-maxBlockSigns+1, nn[0])
+		assert.Equal(t, last, nn[maxBlockSigns-1])
+	}
 }
 
 func TestEmit(t *testing.T) {
@@ -149,3 +332,66 @@ func TestEmit(t *testing.T) {
 			events[3].Parents)
 	})
 }
+
+func TestEmitLimits(t *testing.T) {
+	assertar := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rules := posposet.DefaultRules()
+	rules.MaxEventBytes = 2048
+	rules.MaxEventTxns = 3
+
+	consensus := NewMockConsensus(ctrl)
+	consensus.EXPECT().
+		CurrentEpoch().
+		Return(uint64(0)).
+		AnyTimes()
+	consensus.EXPECT().
+		TotalStake().
+		Return(uint64(0)).
+		AnyTimes()
+	consensus.EXPECT().
+		GetRules().
+		DoAndReturn(func() posposet.ConsensusRules {
+			return rules
+		}).
+		AnyTimes()
+	consensus.EXPECT().
+		StakeOf(gomock.Any()).
+		Return(uint64(0)).
+		AnyTimes()
+	consensus.EXPECT().
+		LastBlockN().
+		Return(uint64(0)).
+		AnyTimes()
+	consensus.EXPECT().
+		PushEvent(gomock.Any()).
+		AnyTimes()
+
+	node := NewForTests("emitter", NewMemStore(), consensus)
+	node.initParents()
+	defer node.Stop()
+
+	payload := make([]byte, 500)
+	for i := 0; i < 5; i++ {
+		payload[0] = byte(i)
+		if !assertar.NoError(node.AddExternalTxn(payload)) {
+			return
+		}
+	}
+
+	// the rest is carried over
+	e1 := node.EmitEvent()
+	assertar.Len(e1.ExternalTransactions, 3)
+	assertar.True(e1.Size() <= int(rules.MaxEventBytes))
+	assertar.Equal(2, node.PendingTxnsCount())
+
+	rules.MaxEventTxns = 0
+	rules.MaxEventBytes = uint64(e1.Size() - 1000)
+	e2 := node.EmitEvent()
+	assertar.Len(e2.ExternalTransactions, 1)
+	assertar.True(e2.Size() <= int(rules.MaxEventBytes))
+	assertar.Equal(1, node.PendingTxnsCount())
+}
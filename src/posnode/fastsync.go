@@ -0,0 +1,309 @@
+package posnode
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
+)
+
+// maxStateNodes is a max count of balances trie nodes per request.
+const maxStateNodes = 256
+
+// fastSync is a state of synchronization from checkpoint
+// instead of replaying all the events since genesis.
+type fastSync struct {
+	pending bool
+	process sync.Mutex
+
+	sync.RWMutex
+}
+
+// isFastSyncPending returns true if fast sync is not done yet.
+func (n *Node) isFastSyncPending() bool {
+	n.fastSync.RLock()
+	defer n.fastSync.RUnlock()
+
+	return n.fastSync.pending
+}
+
+// fastSyncDone switches node to regular sync.
+func (n *Node) fastSyncDone() {
+	n.fastSync.Lock()
+	defer n.fastSync.Unlock()
+
+	n.fastSync.pending = false
+}
+
+// fastSyncWithPeer downloads checkpoint from peer if fast sync is pending.
+// It returns nil when events could be downloaded.
+func (n *Node) fastSyncWithPeer(client api.NodeClient, peer *Peer) error {
+	n.fastSync.process.Lock()
+	defer n.fastSync.process.Unlock()
+
+	if !n.isFastSyncPending() {
+		return nil
+	}
+	// fast sync is for fresh node only
+	if n.consensus == nil || n.consensus.LastBlockN() > 0 {
+		n.fastSyncDone()
+		return nil
+	}
+	// checkpoint of young network is not worth of state download,
+	// handshake of connection has the peer height at connect time, so it is asked again
+	if handshakeOf(client) != nil {
+		hs, err := n.handshake(client)
+		if err != nil {
+			return err
+		}
+		if hs.height < n.conf.FastSyncMinHeight {
+			n.Infof("fast sync: %s is at height %d only, so full sync", peer.ID.String(), hs.height)
+			n.fastSyncDone()
+			return nil
+		}
+	}
+
+	checkpoint, err := n.syncCheckpoint(client, peer)
+	if err != nil {
+		return err
+	}
+	if checkpoint == nil {
+		n.Infof("fast sync: %s has no checkpoint, so full sync", peer.ID.String())
+	}
+
+	n.fastSyncDone()
+	return nil
+}
+
+// fastSyncBehind downloads checkpoint from peer which has pruned events
+// of blocks before the lowest, if the node is behind of them.
+// It returns nil when events could be downloaded.
+func (n *Node) fastSyncBehind(client api.NodeClient, peer *Peer, lowest uint64) error {
+	n.fastSync.process.Lock()
+	defer n.fastSync.process.Unlock()
+
+	// 1st block is the lowest possible, so skip consensus call
+	if lowest <= 1 || n.consensus == nil || n.consensus.LastBlockN()+1 >= lowest {
+		return nil
+	}
+	n.Infof("fast sync: %s has pruned events before block %d", peer.ID.String(), lowest)
+
+	checkpoint, err := n.syncCheckpoint(client, peer)
+	if err != nil {
+		return err
+	}
+	if checkpoint == nil {
+		return fmt.Errorf("%s has pruned events, but has no checkpoint", peer.ID.String())
+	}
+	return nil
+}
+
+// syncCheckpoint downloads and applies checkpoint of peer.
+// Checkpoint is verified by consensus before anything else is downloaded.
+// It returns nil if peer has no checkpoint.
+func (n *Node) syncCheckpoint(client api.NodeClient, peer *Peer) (*posposet.Checkpoint, error) {
+	checkpoint, err := n.downloadCheckpoint(client)
+	if err != nil || checkpoint == nil {
+		return nil, err
+	}
+	block := checkpoint.Cert.Block
+	n.Infof("fast sync: checkpoint is block %d of epoch %d", block.Index, checkpoint.Cert.Epoch)
+
+	sched, err := n.consensus.StateSync(checkpoint)
+	if err != nil {
+		n.ConnectFail(peer, err)
+		n.ScorePeer(peer.ID, scoreBadResponse)
+		return nil, err
+	}
+	if err = n.downloadState(client, sched); err != nil {
+		return nil, err
+	}
+	if err = n.downloadCheckpointEvents(client, peer, block); err != nil {
+		return nil, err
+	}
+	if err = n.consensus.ApplyCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+
+	n.Infof("fast sync: done with block %d", block.Index)
+	return checkpoint, nil
+}
+
+// downloadCheckpoint returns peer's checkpoint or nil if peer has no one.
+func (n *Node) downloadCheckpoint(client api.NodeClient) (*posposet.Checkpoint, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	resp, err := client.GetCheckpoint(ctx, &empty.Empty{})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := wireToCheckpoint(resp)
+	if checkpoint.Cert == nil || checkpoint.Cert.Block == nil {
+		return nil, fmt.Errorf("bad GetCheckpoint() response")
+	}
+
+	return checkpoint, nil
+}
+
+// downloadState downloads balances trie nodes scheduled.
+func (n *Node) downloadState(client api.NodeClient, sched *trie.Sync) error {
+	for queue := sched.Missing(maxStateNodes); len(queue) > 0; queue = sched.Missing(maxStateNodes) {
+		req := &api.StateRequest{
+			Hashes: make([][]byte, len(queue)),
+		}
+		for i, h := range queue {
+			req.Hashes[i] = h.Bytes()
+		}
+
+		resp, err := n.downloadStateNodes(client, req)
+		if err != nil {
+			return err
+		}
+		if len(resp.Nodes) != len(queue) {
+			return fmt.Errorf("bad GetStateNodes() response")
+		}
+
+		results := make([]trie.SyncResult, len(queue))
+		for i, data := range resp.Nodes {
+			if len(data) == 0 {
+				return fmt.Errorf("state node %s is unknown to peer", queue[i].String())
+			}
+			results[i] = trie.SyncResult{Hash: queue[i], Data: data}
+		}
+
+		if _, i, err := sched.Process(results); err != nil {
+			return fmt.Errorf("state node %s: %s", queue[i].String(), err)
+		}
+		if err = n.consensus.CommitStateSync(sched); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *Node) downloadStateNodes(client api.NodeClient, req *api.StateRequest) (*api.StateNodes, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	return client.GetStateNodes(ctx, req)
+}
+
+// downloadCheckpointEvents downloads events of certified block to continue their sequences.
+// Events hashes are checked with the block's EventsRoot before downloading,
+// and events signs are checked with their creators keys.
+// Events are not pushed to consensus, they are before the checkpoint.
+func (n *Node) downloadCheckpointEvents(client api.NodeClient, peer *Peer, b *inter.Block) error {
+	if inter.EventsRootOf(b.Events) != b.EventsRoot {
+		err := fmt.Errorf("events of block %d do not match the events root", b.Index)
+		n.ConnectFail(peer, err)
+		n.ScorePeer(peer.ID, scoreBadResponse)
+		return err
+	}
+
+	for _, h := range b.Events {
+		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+		w, err := client.GetEvent(ctx, &api.EventRequest{Hash: h.Bytes()})
+		cancel()
+		if err != nil {
+			return err
+		}
+
+		e := inter.WireToEvent(w)
+		if e.Hash() != h {
+			err = fmt.Errorf("bad GetEvent() response")
+			n.ConnectFail(peer, err)
+			n.ScorePeer(peer.ID, scoreBadResponse)
+			return err
+		}
+
+		creator, err := n.eventCreator(client, e.Creator)
+		if err != nil {
+			return err
+		}
+		if !e.Verify(creator.PubKey) {
+			err = fmt.Errorf("falsity event %s of %s", h.String(), peer.ID.String())
+			n.ConnectFail(peer, err)
+			n.ScorePeer(peer.ID, scoreInvalidSign)
+			return err
+		}
+
+		n.store.SetEvent(e)
+		n.store.SetEventHash(e.Creator, e.Index, h)
+		if n.store.GetPeerHeight(e.Creator) < e.Index {
+			n.store.SetPeerHeight(e.Creator, e.Index)
+		}
+		n.store.SetTxnsEvent(h, e.Creator, e.InternalTransactions...)
+		n.store.SetExternalTxnsEvent(h, e.ExternalTransactions...)
+		n.dropIncludedTxns(e)
+		n.pushPotentialParent(e)
+	}
+
+	return nil
+}
+
+// eventCreator returns known creator of event or asks peer about.
+func (n *Node) eventCreator(client api.NodeClient, id hash.Peer) (*Peer, error) {
+	if creator := n.store.GetPeer(id); creator != nil {
+		return creator, nil
+	}
+
+	_, info, err := n.requestPeerInfo(client, &id)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || hash.HexToPeer(info.ID) != id {
+		return nil, fmt.Errorf("creator %s is unknown", id.String())
+	}
+	if err = n.setPeerRecord(info); err != nil {
+		return nil, err
+	}
+
+	return WireToPeer(info), nil
+}
+
+/*
+ * Utils:
+ */
+
+func checkpointToWire(c *posposet.Checkpoint) *api.Checkpoint {
+	w := &api.Checkpoint{
+		Cert:   c.Cert.ToWire(),
+		Epochs: make([]*api.EpochProof, len(c.Epochs)),
+	}
+	for i, proof := range c.Epochs {
+		w.Epochs[i] = &api.EpochProof{
+			Validators: proof.Validators.ToWire(),
+			Cert:       proof.Cert.ToWire(),
+		}
+	}
+	return w
+}
+
+func wireToCheckpoint(w *api.Checkpoint) *posposet.Checkpoint {
+	c := &posposet.Checkpoint{
+		Cert:   inter.WireToBlockCertificate(w.Cert),
+		Epochs: make([]*posposet.EpochProof, len(w.Epochs)),
+	}
+	for i, proof := range w.Epochs {
+		c.Epochs[i] = &posposet.EpochProof{
+			Validators: posposet.WireToValidators(proof.Validators),
+			Cert:       inter.WireToBlockCertificate(proof.Cert),
+		}
+	}
+	return c
+}
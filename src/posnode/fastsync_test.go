@@ -0,0 +1,355 @@
+package posnode
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/kvdb"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
+)
+
+func TestFastSync(t *testing.T) {
+	assertar := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// balances of checkpoint
+	src := state.NewDatabase(kvdb.NewMemDatabase())
+	db, err := state.New(hash.Hash{}, src)
+	if !assertar.NoError(err) {
+		return
+	}
+	for i := uint64(1); i <= 50; i++ {
+		db.SetBalance(hash.FakePeer(), i)
+	}
+	root, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+
+	// node 1 has checkpoint
+	consensus1 := NewMockConsensus(ctrl)
+	consensus1.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+	consensus1.EXPECT().
+		LowestBlockN().
+		Return(uint64(1)).
+		AnyTimes()
+
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, consensus1)
+	node1.StartService()
+	defer node1.Stop()
+
+	event := &inter.Event{
+		Index:   1,
+		Creator: node1.ID,
+		Parents: hash.NewEvents(hash.ZeroEvent),
+	}
+	if !assertar.NoError(event.SignBy(node1.key)) {
+		return
+	}
+	store1.SetEvent(event)
+	store1.SetEventHash(event.Creator, event.Index, event.Hash())
+	store1.SetPeerHeight(event.Creator, event.Index)
+
+	block := inter.NewBlock(10, 30, 0, hash.FakeHash(), root, inter.Events{event})
+	checkpoint := &posposet.Checkpoint{
+		Cert: &inter.BlockCertificate{Block: block},
+	}
+
+	consensus1.EXPECT().
+		GetCheckpoint().
+		Return(checkpoint).
+		AnyTimes()
+	consensus1.EXPECT().
+		GetStateNode(gomock.Any()).
+		DoAndReturn(func(h hash.Hash) []byte {
+			data, _ := src.TrieDB().Node(h)
+			return data
+		}).
+		AnyTimes()
+
+	// node 2 is fresh
+	dst := kvdb.NewMemDatabase()
+	consensus2 := NewMockConsensus(ctrl)
+	consensus2.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+	consensus2.EXPECT().
+		LastBlockN().
+		Return(uint64(0))
+	consensus2.EXPECT().
+		StateSync(gomock.Any()).
+		DoAndReturn(func(c *posposet.Checkpoint) (*trie.Sync, error) {
+			return state.NewStateSync(c.Cert.Block.StateRoot, dst), nil
+		})
+	consensus2.EXPECT().
+		CommitStateSync(gomock.Any()).
+		DoAndReturn(func(sched *trie.Sync) error {
+			_, err := sched.Commit(dst)
+			return err
+		}).
+		AnyTimes()
+	consensus2.EXPECT().
+		ApplyCheckpoint(gomock.Any()).
+		Return(nil)
+
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, consensus2)
+	node2.fastSync.pending = true
+	node2.conf.FastSyncMinHeight = 0
+	node2.StartService()
+	defer node2.Stop()
+
+	store2.BootstrapPeers(node1.AsPeer())
+	node2.initPeers()
+
+	assertar.Nil(node2.EmitEvent(), "no emission before fast sync")
+
+	node2.syncWithPeer(node1.AsPeer())
+
+	assertar.False(node2.isFastSyncPending())
+	assertar.Equal(event.Hash(), store2.GetEvent(event.Hash()).Hash())
+	assertar.Equal(event.Index, store2.GetPeerHeight(event.Creator))
+
+	_, err = state.New(root, state.NewDatabase(dst))
+	assertar.NoError(err, "balances are downloaded")
+}
+
+func TestFastSyncBehind(t *testing.T) {
+	assertar := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// node 1 has pruned events before checkpoint
+	consensus1 := NewMockConsensus(ctrl)
+	consensus1.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+	consensus1.EXPECT().
+		LowestBlockN().
+		Return(uint64(10)).
+		AnyTimes()
+
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, consensus1)
+	node1.StartService()
+	defer node1.Stop()
+
+	// empty balances of checkpoint
+	db, err := state.New(hash.Hash{}, state.NewDatabase(kvdb.NewMemDatabase()))
+	if !assertar.NoError(err) {
+		return
+	}
+	root, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+
+	block := inter.NewBlock(10, 30, 0, hash.FakeHash(), root, nil)
+	checkpoint := &posposet.Checkpoint{
+		Cert: &inter.BlockCertificate{Block: block},
+	}
+
+	consensus1.EXPECT().
+		GetCheckpoint().
+		Return(checkpoint).
+		AnyTimes()
+
+	// node 2 is behind of the lowest block
+	dst := kvdb.NewMemDatabase()
+	consensus2 := NewMockConsensus(ctrl)
+	consensus2.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+	consensus2.EXPECT().
+		LastBlockN().
+		Return(uint64(5))
+	consensus2.EXPECT().
+		StateSync(gomock.Any()).
+		DoAndReturn(func(c *posposet.Checkpoint) (*trie.Sync, error) {
+			return state.NewStateSync(c.Cert.Block.StateRoot, dst), nil
+		})
+	consensus2.EXPECT().
+		ApplyCheckpoint(gomock.Any()).
+		Return(nil)
+
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, consensus2)
+	node2.StartService()
+	defer node2.Stop()
+
+	store2.BootstrapPeers(node1.AsPeer())
+	node2.initPeers()
+
+	assertar.False(node2.isFastSyncPending())
+	node2.syncWithPeer(node1.AsPeer())
+}
+
+func TestFastSyncFalsity(t *testing.T) {
+	// empty balances of checkpoint
+	db, err := state.New(hash.Hash{}, state.NewDatabase(kvdb.NewMemDatabase()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := db.Commit(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, forge := range map[string]func(e *inter.Event, b *inter.Block){
+		"unsigned event": func(e *inter.Event, b *inter.Block) {
+			e.Sign = ""
+		},
+		"events root mismatch": func(e *inter.Event, b *inter.Block) {
+			b.Events = append(b.Events, hash.FakeEvent())
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assertar := assert.New(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// node 1 has forged checkpoint
+			consensus1 := NewMockConsensus(ctrl)
+			consensus1.EXPECT().
+				GetGenesisHash().
+				Return(hash.Hash{}).
+				AnyTimes()
+			consensus1.EXPECT().
+				LowestBlockN().
+				Return(uint64(1)).
+				AnyTimes()
+
+			store1 := NewMemStore()
+			node1 := NewForTests("node1", store1, consensus1)
+			node1.StartService()
+			defer node1.Stop()
+
+			event := &inter.Event{
+				Index:   1,
+				Creator: node1.ID,
+				Parents: hash.NewEvents(hash.ZeroEvent),
+			}
+			if !assertar.NoError(event.SignBy(node1.key)) {
+				return
+			}
+			block := inter.NewBlock(10, 30, 0, hash.FakeHash(), root, inter.Events{event})
+			forge(event, block)
+			store1.SetEvent(event)
+			store1.SetEventHash(event.Creator, event.Index, event.Hash())
+			store1.SetPeerHeight(event.Creator, event.Index)
+
+			consensus1.EXPECT().
+				GetCheckpoint().
+				Return(&posposet.Checkpoint{
+					Cert: &inter.BlockCertificate{Block: block},
+				}).
+				AnyTimes()
+
+			// node 2 is fresh, so checkpoint is not applied
+			consensus2 := NewMockConsensus(ctrl)
+			consensus2.EXPECT().
+				GetGenesisHash().
+				Return(hash.Hash{}).
+				AnyTimes()
+			consensus2.EXPECT().
+				LastBlockN().
+				Return(uint64(0))
+			consensus2.EXPECT().
+				StateSync(gomock.Any()).
+				DoAndReturn(func(c *posposet.Checkpoint) (*trie.Sync, error) {
+					return state.NewStateSync(c.Cert.Block.StateRoot, kvdb.NewMemDatabase()), nil
+				})
+
+			store2 := NewMemStore()
+			node2 := NewForTests("node2", store2, consensus2)
+			node2.fastSync.pending = true
+			node2.conf.FastSyncMinHeight = 0
+			node2.StartService()
+			defer node2.Stop()
+
+			store2.BootstrapPeers(node1.AsPeer())
+			node2.initPeers()
+
+			node2.syncWithPeer(node1.AsPeer())
+
+			assertar.True(node2.isFastSyncPending())
+			assertar.Nil(store2.GetEvent(event.Hash()))
+		})
+	}
+}
+
+func TestFastSyncLowPeer(t *testing.T) {
+	assertar := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	consensus := NewMockConsensus(ctrl)
+	consensus.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+	consensus.EXPECT().
+		LastBlockN().
+		Return(uint64(0)).
+		AnyTimes()
+
+	node := NewForTests("node", NewMemStore(), consensus)
+	node.fastSync.pending = true
+	node.conf.FastSyncMinHeight = 10
+
+	hello := func(height uint64) *api.Hello {
+		return &api.Hello{
+			Versions: protocolVersions,
+			Height:   height,
+		}
+	}
+
+	// no checkpoint is requested from peer with low head
+	low := api.NewMockNodeClient(ctrl)
+	low.EXPECT().
+		Handshake(gomock.Any(), gomock.Any()).
+		Return(hello(9), nil)
+	client := &peerClient{
+		NodeClient: low,
+		hs:         &handshake{height: 9},
+	}
+	assertar.NoError(node.fastSyncWithPeer(client, FakePeer("peer")))
+	assertar.False(node.isFastSyncPending(), "full sync")
+
+	// height of peer is asked again, not taken from connect time
+	node.fastSync.pending = true
+	grown := api.NewMockNodeClient(ctrl)
+	grown.EXPECT().
+		Handshake(gomock.Any(), gomock.Any()).
+		Return(hello(10), nil)
+	grown.EXPECT().
+		GetCheckpoint(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "no checkpoint"))
+	client = &peerClient{
+		NodeClient: grown,
+		hs:         &handshake{height: 9},
+	}
+	assertar.NoError(node.fastSyncWithPeer(client, FakePeer("peer")))
+	assertar.False(node.isFastSyncPending(), "full sync as peer has no checkpoint")
+}
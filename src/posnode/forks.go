@@ -0,0 +1,114 @@
+package posnode
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+// forks collects proofs of creators misbehaviour
+// to gossip them with own events.
+type forks struct {
+	pending map[hash.Peer]*inter.ForkProof
+
+	sync.Mutex
+}
+
+// checkFork makes proof if event is a fork
+// of known event with the same creator and index.
+// It is not safe for concurrent use.
+func (n *Node) checkFork(e *inter.Event) {
+	prev := n.store.GetEventHash(e.Creator, e.Index)
+	if prev == nil || *prev == e.Hash() {
+		return
+	}
+	n.Warnf("fork detected: %s and %s of %s", prev.String(), e.Hash().String(), e.Creator.String())
+
+	if n.store.HasForkProof(e.Creator) {
+		return
+	}
+
+	var pub *common.PublicKey
+	if e.Creator == n.ID {
+		pub = n.pub
+	} else if peer := n.store.GetPeer(e.Creator); peer != nil {
+		pub = peer.PubKey
+	} else {
+		n.Warnf("unknown pubkey of %s, so fork proof skipped", e.Creator.String())
+		return
+	}
+
+	first := n.store.GetEventHeader(*prev)
+	if first == nil {
+		n.Warnf("event %s not found, so fork proof skipped", prev.String())
+		return
+	}
+
+	proof := inter.NewForkProof(pub, first, e.Header())
+	if err := proof.Verify(); err != nil {
+		n.Warnf("fork proof is invalid: %s", err)
+		return
+	}
+
+	n.store.SetForkProof(proof)
+
+	n.forks.Lock()
+	defer n.forks.Unlock()
+	if n.forks.pending == nil {
+		n.forks.pending = make(map[hash.Peer]*inter.ForkProof)
+	}
+	n.forks.pending[proof.Cheater()] = proof
+}
+
+// takeForkProofs remembers fork proofs gossiped with event.
+// It is not safe for concurrent use.
+func (n *Node) takeForkProofs(e *inter.Event) {
+	for _, proof := range e.ForkProofs {
+		if err := proof.Verify(); err != nil {
+			n.Warnf("fork proof from %s is invalid: %s", e.Creator.String(), err)
+			continue
+		}
+		cheater := proof.Cheater()
+		if !n.store.HasForkProof(cheater) {
+			n.store.SetForkProof(proof)
+		}
+
+		n.forks.Lock()
+		delete(n.forks.pending, cheater)
+		n.forks.Unlock()
+	}
+}
+
+// popForkProofs returns pending fork proofs to include them into new event.
+// Proofs out of budget wait for the next event.
+func (n *Node) popForkProofs(budget *eventBudget) []*inter.ForkProof {
+	n.forks.Lock()
+	defer n.forks.Unlock()
+
+	if len(n.forks.pending) == 0 {
+		return nil
+	}
+
+	res := make([]*inter.ForkProof, 0, len(n.forks.pending))
+	for _, proof := range n.forks.pending {
+		res = append(res, proof)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i].Cheater(), res[j].Cheater()
+		return a.Hex() < b.Hex()
+	})
+	for i, proof := range res {
+		if !budget.TakeBytes(proto.Size(proof.ToWire())) {
+			res = res[:i]
+			break
+		}
+		delete(n.forks.pending, proof.Cheater())
+	}
+
+	return res
+}
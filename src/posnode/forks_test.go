@@ -0,0 +1,72 @@
+package posnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/ordering"
+)
+
+func TestForkDetection(t *testing.T) {
+	assertar := assert.New(t)
+
+	store := NewMemStore()
+	node := NewForTests("node", store, nil)
+	node.initParents()
+	defer node.Stop()
+
+	key := crypto.GenerateKey()
+	cheater := &Peer{
+		ID:     hash.PeerOfPubkey(key.Public()),
+		PubKey: key.Public(),
+		Host:   "cheater",
+	}
+	store.SetPeer(cheater)
+
+	event := func(index uint64, parent hash.Event, tx string) *inter.Event {
+		e := &inter.Event{
+			Index:                index,
+			Creator:              cheater.ID,
+			Parents:              hash.NewEvents(parent),
+			LamportTime:          inter.Timestamp(index),
+			ExternalTransactions: [][]byte{[]byte(tx)},
+		}
+		if err := e.SignBy(key); err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	e1, e2 := event(1, hash.ZeroEvent, "1"), event(1, hash.ZeroEvent, "2")
+
+	node.onNewEvent(e1)
+	node.onNewEvent(e2)
+	child := event(2, e2.Hash(), "3")
+	node.onNewEvent(child)
+
+	if !assertar.True(store.HasForkProof(cheater.ID)) {
+		return
+	}
+	assertar.Equal(e1.Hash(), *store.GetEventHash(cheater.ID, 1))
+	assertar.NotNil(store.GetEvent(e2.Hash()), "fork is saved to keep DAG complete")
+	assertar.NotNil(store.GetEvent(child.Hash()), "descendant of fork is complete")
+
+	proof := store.GetForkProof(cheater.ID)
+	assertar.Equal(hash.NewEvents(e1.Hash(), e2.Hash()), hash.NewEvents(proof.First.Hash(), proof.Second.Hash()))
+
+	// out of budget, so waits for the next event
+	assertar.Empty(node.popForkProofs(newEventBudget(ordering.Limits{MaxBytes: 1}, 0)))
+
+	emitted := node.EmitEvent()
+	if !assertar.Equal(1, len(emitted.ForkProofs)) {
+		return
+	}
+	assertar.Equal(cheater.ID, emitted.ForkProofs[0].Cheater())
+	assertar.Equal(2, len(emitted.Parents), "self-parent and the only fork branch")
+
+	emitted = node.EmitEvent()
+	assertar.Equal(0, len(emitted.ForkProofs))
+}
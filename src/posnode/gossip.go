@@ -3,11 +3,13 @@ package posnode
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
 )
 
@@ -92,40 +94,31 @@ func (n *Node) syncWithPeer(peer *Peer) {
 	}
 	defer free()
 
-	unknowns, err := n.compareKnownEvents(client, peer)
+	if err := n.fastSyncWithPeer(client, peer); err != nil {
+		fail(err)
+		return
+	}
+
+	unknowns, lowest, err := n.compareKnownEvents(client, peer)
 	if err != nil {
 		fail(err)
 		return
 	}
+	if err := n.fastSyncBehind(client, peer, lowest); err != nil {
+		fail(err)
+		return
+	}
 	if unknowns == nil {
 		return
 	}
 
-	peers2discovery := make(map[hash.Peer]struct{})
-	parents := hash.Events{}
-
 	toDownload := n.lockFreeHeights(unknowns)
 	defer n.unlockFreeHeights(toDownload)
 
-	for creator, interval := range toDownload {
-		req := &api.EventRequest{
-			PeerID: creator.Hex(),
-		}
-		for i := interval.from; i <= interval.to; i++ {
-			req.Index = i
-
-			event, err := n.downloadEvent(client, peer, req)
-			if err != nil {
-				fail(err)
-				return
-			}
-			if event == nil {
-				return
-			}
-
-			peers2discovery[creator] = struct{}{}
-			parents.Add(event.Parents.Slice()...)
-		}
+	peers2discovery, parents, err := n.downloadEvents(client, peer, toDownload)
+	if err != nil {
+		fail(err)
+		return
 	}
 	n.ConnectOK(peer)
 
@@ -165,7 +158,9 @@ func (n *Node) checkParents(client api.NodeClient, peer *Peer, parents hash.Even
 	}
 }
 
-func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.Peer]uint64, error) {
+// compareKnownEvents returns heights of events unknown to the node
+// and the lowest block which events peer has still.
+func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.Peer]uint64, uint64, error) {
 	knowns := n.knownEvents()
 
 	req := &api.KnownEvents{
@@ -180,14 +175,16 @@ func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.P
 
 	id, ctx := api.ServerPeerID(ctx)
 
+	start := time.Now()
 	resp, err := client.SyncEvents(ctx, req)
 	if err != nil {
 		n.ConnectFail(peer, err)
-		return nil, err
+		return nil, 0, err
 	}
+	n.scoreLatency(peer, time.Since(start))
 
-	if *id != peer.ID {
-		// TODO: skip or continue gossiping with peer id ?
+	if err = n.checkServerID(peer, *id); err != nil {
+		return nil, 0, err
 	}
 
 	res := make(map[hash.Peer]uint64, len(resp.Lasts))
@@ -196,7 +193,117 @@ func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.P
 	}
 
 	n.ConnectOK(peer)
-	return res, nil
+	return res, resp.LowestBlockN, nil
+}
+
+// downloadEvents downloads events of heights intervals in parent-first order.
+// It returns creators and parents of events for discovery.
+// Download stops on event of unknown yet creator.
+func (n *Node) downloadEvents(client api.NodeClient, peer *Peer, toDownload map[hash.Peer]interval) (
+	creators map[hash.Peer]struct{},
+	parents hash.Events,
+	err error,
+) {
+	creators = make(map[hash.Peer]struct{})
+	parents = hash.Events{}
+	if len(toDownload) < 1 {
+		return
+	}
+
+	if !supports(client, capStreamEvents) {
+		err = n.downloadEventsByOne(client, peer, toDownload, creators, parents)
+		return
+	}
+
+	req := &api.EventsRequest{
+		Heights:  make(map[string]*api.Interval, len(toDownload)),
+		MaxBytes: n.conf.SyncBatchBytes,
+	}
+	nexts := make(map[string]uint64, len(toDownload))
+	for creator, interval := range toDownload {
+		req.Heights[creator.Hex()] = &api.Interval{
+			From: interval.from,
+			To:   interval.to,
+		}
+		nexts[creator.Hex()] = interval.from
+	}
+
+	// cancel() closes the stream
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	id, ctx := api.ServerPeerID(ctx)
+
+	n.Info("download events")
+
+	stream, err := client.StreamEvents(ctx, req)
+	if err != nil {
+		n.ConnectFail(peer, err)
+		return
+	}
+
+	for {
+		var w *wire.Event
+		w, err = stream.Recv()
+		if err == io.EOF {
+			// server peer ID is known at the stream end only
+			err = n.checkServerID(peer, *id)
+			return
+		}
+		if err != nil {
+			n.ConnectFail(peer, err)
+			return
+		}
+
+		if next, ok := nexts[w.Creator]; !ok || w.Index != next {
+			err = fmt.Errorf("bad StreamEvents() response")
+			n.ConnectFail(peer, err)
+			n.ScorePeer(peer.ID, scoreBadResponse)
+			return
+		}
+		nexts[w.Creator]++
+
+		event := inter.WireToEvent(w)
+		creators[event.Creator] = struct{}{}
+
+		var known bool
+		known, err = n.verifyEvent(peer, event)
+		if err != nil || !known {
+			return
+		}
+
+		n.onNewEvent(event)
+		n.ScorePeer(peer.ID, scoreUsefulEvent)
+
+		parents.Add(event.Parents.Slice()...)
+	}
+}
+
+// downloadEventsByOne downloads events one by one for peers not supporting stream.
+func (n *Node) downloadEventsByOne(client api.NodeClient, peer *Peer, toDownload map[hash.Peer]interval,
+	creators map[hash.Peer]struct{}, parents hash.Events) error {
+
+	for creator, interval := range toDownload {
+		req := &api.EventRequest{
+			PeerID: creator.Hex(),
+		}
+		for i := interval.from; i <= interval.to; i++ {
+			req.Index = i
+
+			event, err := n.downloadEvent(client, peer, req)
+			if err != nil {
+				return err
+			}
+			if event == nil {
+				return nil
+			}
+
+			creators[creator] = struct{}{}
+			parents.Add(event.Parents.Slice()...)
+		}
+	}
+
+	return nil
 }
 
 // downloadEvent downloads event.
@@ -214,33 +321,68 @@ func (n *Node) downloadEvent(client api.NodeClient, peer *Peer, req *api.EventRe
 		return nil, err
 	}
 
-	if *id != peer.ID {
-		// TODO: skip or continue gossiping with peer id ?
+	if err = n.checkServerID(peer, *id); err != nil {
+		return nil, err
 	}
 
-	if req.Hash == nil {
-		if w.Creator != req.PeerID || w.Index != req.Index {
-			n.ConnectFail(peer, fmt.Errorf("bad GetEvent() response"))
-			return nil, nil
-		}
+	event := inter.WireToEvent(w)
+
+	// event should be the requested one
+	if (req.Hash == nil && (w.Creator != req.PeerID || w.Index != req.Index)) ||
+		(req.Hash != nil && event.Hash() != hash.BytesToEventHash(req.Hash)) {
+		n.ConnectFail(peer, fmt.Errorf("bad GetEvent() response"))
+		n.ScorePeer(peer.ID, scoreBadResponse)
+		return nil, nil
 	}
 
-	event := inter.WireToEvent(w)
+	known, err := n.verifyEvent(peer, event)
+	if err != nil || !known {
+		return nil, err
+	}
+
+	n.onNewEvent(event)
+	n.ScorePeer(peer.ID, scoreUsefulEvent)
+
+	return event, nil
+}
 
+// verifyEvent checks event sign and creator stake.
+// It returns false if creator is unknown yet.
+// Sign is checked first, so peer is penalized for its own fault only:
+// for falsity event or for event of creator which has no stake at the latest state.
+func (n *Node) verifyEvent(peer *Peer, event *inter.Event) (bool, error) {
 	// check event sign
 	creator := n.store.GetPeer(event.Creator)
 	if creator == nil {
-		return nil, nil
+		return false, nil
 	}
 	if !event.Verify(creator.PubKey) {
-		err = fmt.Errorf("falsity GetEvent() response")
+		err := fmt.Errorf("falsity event %s of %s", event.Hash().String(), peer.ID.String())
 		n.ConnectFail(peer, err)
-		return nil, err
+		n.ScorePeer(peer.ID, scoreInvalidSign)
+		return false, err
 	}
 
-	n.onNewEvent(event)
+	// check creator stake to not store events of anyone
+	if n.consensus != nil && n.consensus.StakeOf(event.Creator) < 1 {
+		err := fmt.Errorf("creator %s of event %s has no stake", event.Creator.String(), event.Hash().String())
+		n.ConnectFail(peer, err)
+		n.ScorePeer(peer.ID, scoreBadResponse)
+		return false, err
+	}
 
-	return event, nil
+	return true, nil
+}
+
+// checkServerID checks that the peer itself has answered, not another one at its address.
+func (n *Node) checkServerID(peer *Peer, id hash.Peer) error {
+	if id == peer.ID {
+		return nil
+	}
+
+	err := fmt.Errorf("peer %s answered instead of %s", id.String(), peer.ID.String())
+	n.ConnectFail(peer, err)
+	return err
 }
 
 // knownEventsReq makes request struct with event heights of top peers.
@@ -277,8 +419,18 @@ func (n *gossipEvaluation) Swap(i, j int) {
 // Less reports whether the element with
 // index i should sort before the element with index j.
 func (n *gossipEvaluation) Less(i, j int) bool {
-	a := n.peers.attrByID(n.peers.top[i]).Host
-	b := n.peers.attrByID(n.peers.top[j]).Host
+	pa := n.peers.attrByID(n.peers.top[i])
+	pb := n.peers.attrByID(n.peers.top[j])
+
+	now := time.Now()
+	sa := pa.score(now, n.conf.ScoreHalfLife)
+	sb := pb.score(now, n.conf.ScoreHalfLife)
+	if sa != sb {
+		return sa > sb
+	}
+
+	a := pa.Host
+	b := pb.Host
 
 	if a.LastSuccess.After(a.LastFail) && !b.LastSuccess.After(b.LastFail) {
 		return true
@@ -1,8 +1,11 @@
 package posnode
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
@@ -102,6 +105,70 @@ func TestGossip(t *testing.T) {
 
 }
 
+func TestGossipOverTLS(t *testing.T) {
+	assertar := assert.New(t)
+
+	// node 1
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, nil)
+	node1.conf.TLS = true
+	node1.StartService()
+	defer node1.Stop()
+
+	// node 2
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, nil)
+	node2.conf.TLS = true
+	node2.StartService()
+	defer node2.Stop()
+
+	store2.BootstrapPeers(node1.AsPeer())
+	node2.initPeers()
+
+	e := node1.EmitEvent()
+	node2.syncWithPeer(node1.AsPeer())
+
+	got := store2.GetEventHash(node1.ID, e.Index)
+	if !assertar.NotNil(got, "event of node1 is in db") {
+		return
+	}
+	assertar.Equal(e.Hash(), *got)
+}
+
+func TestGossipWrongPeer(t *testing.T) {
+	for _, tls := range []bool{false, true} {
+		t.Run(fmt.Sprintf("TLS=%t", tls), func(t *testing.T) {
+			assertar := assert.New(t)
+
+			// node 1
+			store1 := NewMemStore()
+			node1 := NewForTests("node1", store1, nil)
+			node1.conf.TLS = tls
+			node1.StartService()
+			defer node1.Stop()
+
+			// node 2
+			store2 := NewMemStore()
+			node2 := NewForTests("node2", store2, nil)
+			node2.conf.TLS = tls
+			node2.conf.ConnectTimeout = time.Millisecond * 100
+			node2.StartService()
+			defer node2.Stop()
+
+			// another peer is expected at node1 address
+			peer := node1.AsPeer()
+			peer.ID = hash.FakePeer()
+			store2.BootstrapPeers(node1.AsPeer())
+			node2.initPeers()
+
+			e := node1.EmitEvent()
+			node2.syncWithPeer(peer)
+
+			assertar.Nil(store2.GetEventHash(node1.ID, e.Index), "event is not taken from wrong peer")
+		})
+	}
+}
+
 func TestMissingParents(t *testing.T) {
 	// node 1
 	store1 := NewMemStore()
@@ -156,7 +223,7 @@ func TestMissingParents(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		unknowns, err := node2.compareKnownEvents(client, peer)
+		unknowns, _, err := node2.compareKnownEvents(client, peer)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -304,3 +371,44 @@ func TestPeerPriority(t *testing.T) {
 			"should select peer1 as first not busy in top peer")
 	})
 }
+
+func TestGossipZeroStake(t *testing.T) {
+	assertar := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// node 1
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, nil)
+	node1.StartService()
+	defer node1.Stop()
+
+	// node 2 knows node1 has no stake
+	consensus := NewMockConsensus(ctrl)
+	consensus.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+	consensus.EXPECT().
+		StakeOf(node1.ID).
+		Return(uint64(0)).
+		AnyTimes()
+
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, consensus)
+	node2.StartService()
+	defer node2.Stop()
+
+	// connect nodes to each other
+	store2.BootstrapPeers(node1.AsPeer())
+	node2.initPeers()
+
+	node1.EmitEvent()
+
+	node2.syncWithPeer(node1.AsPeer())
+
+	assertar.Nil(node2.store.GetEventHash(node1.ID, 1), "event of zero-stake node1 is rejected")
+	assertar.False(node2.PeerReadyForReq(node1.host), "node1 is penalized")
+	assertar.True(node2.PeerScore(node1.ID) < 0, "node1 is penalized")
+}
@@ -0,0 +1,151 @@
+package posnode
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// Capabilities of node protocol.
+const (
+	capStreamEvents = "stream_events"
+	capAnnounce     = "announce"
+	capGzip         = "gzip"
+	capTxns         = "txns"
+)
+
+// capabilities are optional features supported.
+var capabilities = []string{
+	capStreamEvents,
+	capAnnounce,
+	capGzip,
+	capTxns,
+}
+
+// handshake is a result of protocol negotiation with peer.
+type handshake struct {
+	version uint32
+	caps    map[string]bool
+	height  uint64 // the last self event index of peer at handshake time
+}
+
+// Handshake negotiates protocol with client.
+func (n *Node) Handshake(ctx context.Context, req *api.Hello) (*api.Hello, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	hello := n.hello()
+
+	if _, err := negotiate(hello, req); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return hello, nil
+}
+
+// handshake negotiates protocol with server.
+func (n *Node) handshake(client api.NodeClient) (*handshake, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	hello := n.hello()
+	resp, err := client.Handshake(ctx, hello)
+	if status.Code(err) == codes.Unimplemented {
+		return legacyHandshake(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return negotiate(hello, resp)
+}
+
+// hello returns self protocol params.
+func (n *Node) hello() *api.Hello {
+	hello := &api.Hello{
+		Versions:     protocolVersions,
+		Capabilities: capabilities,
+	}
+
+	if n.consensus != nil {
+		genesis := n.consensus.GetGenesisHash()
+		hello.Genesis = genesis.Bytes()
+	}
+	if n.store != nil {
+		hello.Height = n.store.GetPeerHeight(n.ID)
+	}
+
+	return hello
+}
+
+/*
+ * Utils:
+ */
+
+// legacyHandshake returns protocol of peer without Handshake rpc:
+// the first version with no optional capabilities and unknown height.
+func legacyHandshake() *handshake {
+	return &handshake{
+		version: protocolVersions[0],
+		caps:    map[string]bool{},
+	}
+}
+
+// negotiate returns the highest common version and common capabilities.
+func negotiate(self, other *api.Hello) (*handshake, error) {
+	if hash.FromBytes(self.Genesis) != hash.FromBytes(other.Genesis) {
+		return nil, fmt.Errorf("peer uses another genesis")
+	}
+
+	hs := &handshake{
+		caps:   make(map[string]bool, len(other.Capabilities)),
+		height: other.Height,
+	}
+
+	for _, v := range self.Versions {
+		for _, w := range other.Versions {
+			if v == w && v > hs.version {
+				hs.version = v
+			}
+		}
+	}
+	if hs.version == 0 {
+		return nil, fmt.Errorf("no common protocol version with peer (%v and %v)", self.Versions, other.Versions)
+	}
+
+	for _, c := range self.Capabilities {
+		for _, d := range other.Capabilities {
+			if c == d {
+				hs.caps[c] = true
+			}
+		}
+	}
+
+	return hs, nil
+}
+
+// supports returns true if the peer of client supports the capability.
+// Clients not of ConnectTo() (mocks) support all.
+func supports(client api.NodeClient, capability string) bool {
+	hs := handshakeOf(client)
+	if hs == nil {
+		return true
+	}
+	return hs.caps[capability]
+}
+
+// handshakeOf returns result of protocol negotiation with the peer of client
+// or nil if client is not of ConnectTo() (mocks).
+func handshakeOf(client api.NodeClient) *handshake {
+	c, ok := client.(*peerClient)
+	if !ok {
+		return nil
+	}
+	return c.hs
+}
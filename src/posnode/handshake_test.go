@@ -0,0 +1,116 @@
+package posnode
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+func TestNegotiate(t *testing.T) {
+	self := &api.Hello{
+		Versions:     []uint32{1, 2, 3},
+		Capabilities: []string{capStreamEvents, capGzip},
+	}
+
+	t.Run("common", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		hs, err := negotiate(self, &api.Hello{
+			Versions:     []uint32{2, 4},
+			Capabilities: []string{capGzip, capAnnounce},
+			Height:       7,
+		})
+		if !assertar.NoError(err) {
+			return
+		}
+		assertar.Equal(uint32(2), hs.version)
+		assertar.Equal(map[string]bool{capGzip: true}, hs.caps)
+		assertar.Equal(uint64(7), hs.height)
+	})
+
+	t.Run("no common version", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		_, err := negotiate(self, &api.Hello{
+			Versions: []uint32{4},
+		})
+		assertar.Error(err)
+	})
+
+	t.Run("another genesis", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		_, err := negotiate(self, &api.Hello{
+			Versions: []uint32{1},
+			Genesis:  hash.FakeHash().Bytes(),
+		})
+		assertar.Error(err)
+	})
+}
+
+func TestHandshake(t *testing.T) {
+	assertar := assert.New(t)
+
+	server := NewForTests("server.fake", NewMemStore(), nil)
+	server.StartService()
+	defer server.Stop()
+
+	node := NewForTests("client.fake", NewMemStore(), nil)
+	node.initClient()
+	defer node.Stop()
+
+	client, free, _, err := node.ConnectTo(server.AsPeer())
+	if !assertar.NoError(err) {
+		return
+	}
+	defer free()
+
+	// cached in connection
+	conn, err := node.connPool.Get(node.NetAddrOf(server.host), server.ID)
+	if !assertar.NoError(err) {
+		return
+	}
+	defer node.connPool.Release(conn, true, nil)
+	if !assertar.NotNil(conn.hs) {
+		return
+	}
+	assertar.Equal(conn.hs, client.(*peerClient).hs)
+	assertar.Equal(protocolVersions[len(protocolVersions)-1], conn.hs.version)
+
+	for _, c := range capabilities {
+		assertar.True(supports(client, c))
+	}
+
+	// peer without the capability
+	delete(conn.hs.caps, capStreamEvents)
+	assertar.False(supports(client, capStreamEvents))
+}
+
+func TestHandshakeLegacy(t *testing.T) {
+	assertar := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := api.NewMockNodeClient(ctrl)
+	client.EXPECT().
+		Handshake(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unimplemented, "unknown method Handshake"))
+
+	node := NewForTests("node", NewMemStore(), nil)
+
+	hs, err := node.handshake(client)
+	if !assertar.NoError(err) {
+		return
+	}
+	assertar.Equal(protocolVersions[0], hs.version)
+	for _, c := range capabilities {
+		assertar.False(hs.caps[c])
+	}
+}
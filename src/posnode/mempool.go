@@ -0,0 +1,365 @@
+package posnode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+const (
+	// maxTxnsBatch is a max count of txns per gossip message.
+	maxTxnsBatch = 256
+	// relayDelay is a count of emission intervals to wait for txn of peer
+	// to be included by others before to include it into self event.
+	relayDelay = 2
+)
+
+type (
+	// pendingTxn is an external txn waiting for event.
+	pendingTxn struct {
+		payload []byte
+		origin  hash.Peer // node the txn is taken by from client
+		added   time.Time
+	}
+
+	// mempool is a bounded pool of pending external txns
+	// with gossip of them to peers.
+	mempool struct {
+		txns    map[hash.Transaction]*pendingTxn
+		origins map[hash.Peer]int
+
+		gossips chan *api.Txn
+		done    chan struct{}
+		wg      sync.WaitGroup
+
+		sync.RWMutex
+	}
+)
+
+// StartTxnGossip starts sending of new pending txns to peers.
+func (n *Node) StartTxnGossip() {
+	if n.mempool.done != nil {
+		return
+	}
+
+	n.initClient()
+	n.initPeers()
+
+	gossips := make(chan *api.Txn, maxTxnsBatch*4) // magic buffer size.
+	n.mempool.Lock()
+	n.mempool.gossips = gossips
+	n.mempool.Unlock()
+
+	n.mempool.done = make(chan struct{})
+	done := n.mempool.done
+
+	n.mempool.wg.Add(1)
+	go func() {
+		defer n.mempool.wg.Done()
+		for {
+			select {
+			case txn := <-gossips:
+				n.sendTxns(n.collectTxns(gossips, txn))
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	n.Info("txns gossip started")
+}
+
+// StopTxnGossip stops sending of pending txns.
+func (n *Node) StopTxnGossip() {
+	if n.mempool.done == nil {
+		return
+	}
+
+	close(n.mempool.done)
+	n.mempool.done = nil
+	n.mempool.wg.Wait()
+
+	n.mempool.Lock()
+	n.mempool.gossips = nil
+	n.mempool.Unlock()
+
+	n.Info("txns gossip stopped")
+}
+
+// AddExternalTxn takes external transaction of local client for new event.
+// Txns are emitted in order of arrival: the fee is paid by event creator
+// (see posposet.Economy), so sender offers nothing to prioritize by.
+// Local clients are not distinguishable here, so their txns are limited
+// by MempoolSize only, MempoolPerSender is for txns of peers.
+func (n *Node) AddExternalTxn(tx []byte) error {
+	payload := make([]byte, len(tx))
+	copy(payload, tx)
+
+	txn := &api.Txn{
+		Payload: payload,
+		Origin:  n.ID.Hex(),
+		Sign:    signTxn(payload, n.key),
+	}
+	return n.addPendingTxn(txn, n.ID)
+}
+
+// addPendingTxn puts txn of origin into mempool and queues it to gossip.
+func (n *Node) addPendingTxn(txn *api.Txn, origin hash.Peer) error {
+	if err := n.poolTxn(txn, origin); err != nil {
+		return err
+	}
+
+	n.gossipTxn(txn)
+	return nil
+}
+
+// poolTxn puts txn of origin into mempool.
+func (n *Node) poolTxn(txn *api.Txn, origin hash.Peer) error {
+	payload := txn.Payload
+	if len(payload) < 1 {
+		return fmt.Errorf("empty txn")
+	}
+
+	idx := inter.ExternalTransactionHashOf(payload)
+	// submitter of own txn is a local client, not the node itself
+	limited := origin != n.ID
+
+	n.mempool.Lock()
+	defer n.mempool.Unlock()
+
+	if n.mempool.txns == nil {
+		n.mempool.txns = make(map[hash.Transaction]*pendingTxn)
+		n.mempool.origins = make(map[hash.Peer]int)
+	}
+
+	if _, ok := n.mempool.txns[idx]; ok {
+		return fmt.Errorf("the same txn is in mempool already")
+	}
+
+	if n.store.HasTxnsEvent(idx) {
+		return fmt.Errorf("the same txn already exists in event")
+	}
+
+	now := time.Now()
+
+	if len(n.mempool.txns) >= n.conf.MempoolSize || (limited && n.mempool.origins[origin] >= n.conf.MempoolPerSender) {
+		n.evictExpiredTxns(now)
+	}
+
+	if limited && n.mempool.origins[origin] >= n.conf.MempoolPerSender {
+		return fmt.Errorf("too many pending txns of %s, max is %d", origin.String(), n.conf.MempoolPerSender)
+	}
+
+	if len(n.mempool.txns) >= n.conf.MempoolSize {
+		return fmt.Errorf("mempool is full, max is %d", n.conf.MempoolSize)
+	}
+
+	n.mempool.txns[idx] = &pendingTxn{
+		payload: payload,
+		origin:  origin,
+		added:   now,
+	}
+	n.mempool.origins[origin]++
+
+	return nil
+}
+
+// popReadyTxns takes txns for the next self event, the oldest first.
+// Txns out of budget are left for the next event.
+func (n *Node) popReadyTxns(budget *eventBudget) [][]byte {
+	n.mempool.Lock()
+	defer n.mempool.Unlock()
+
+	now := time.Now()
+	n.evictExpiredTxns(now)
+
+	ready := make([]hash.Transaction, 0, len(n.mempool.txns))
+	for idx, txn := range n.mempool.txns {
+		if n.isReadyTxn(txn, now) {
+			ready = append(ready, idx)
+		}
+	}
+
+	sort.Slice(ready, func(i, j int) bool {
+		a, b := n.mempool.txns[ready[i]], n.mempool.txns[ready[j]]
+		return a.added.Before(b.added)
+	})
+
+	var res [][]byte
+	for _, idx := range ready {
+		txn := n.mempool.txns[idx]
+		if !budget.Take(len(txn.payload)) {
+			continue
+		}
+		res = append(res, txn.payload)
+		n.removeTxn(idx)
+	}
+
+	return res
+}
+
+// dropIncludedTxns removes txns of event from mempool.
+func (n *Node) dropIncludedTxns(e *inter.Event) {
+	if len(e.ExternalTransactions) < 1 {
+		return
+	}
+
+	n.mempool.Lock()
+	defer n.mempool.Unlock()
+
+	for _, payload := range e.ExternalTransactions {
+		n.removeTxn(inter.ExternalTransactionHashOf(payload))
+	}
+}
+
+// hasReadyTxns returns true if there are txns for the next self event.
+func (n *Node) hasReadyTxns(now time.Time) bool {
+	n.mempool.Lock()
+	defer n.mempool.Unlock()
+
+	for _, txn := range n.mempool.txns {
+		if n.isReadyTxn(txn, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingTxnsCount returns count of external txns in mempool.
+func (n *Node) PendingTxnsCount() int {
+	n.mempool.Lock()
+	defer n.mempool.Unlock()
+
+	return len(n.mempool.txns)
+}
+
+// gossipTxn queues txn to send to peers if txns gossip is started.
+func (n *Node) gossipTxn(txn *api.Txn) {
+	n.mempool.RLock()
+	defer n.mempool.RUnlock()
+
+	if n.mempool.gossips == nil {
+		return
+	}
+
+	select {
+	case n.mempool.gossips <- txn:
+	default:
+		n.Warn("mempool.gossips queue is full, so skipped")
+	}
+}
+
+// collectTxns returns queued txns as a batch.
+func (n *Node) collectTxns(gossips chan *api.Txn, first *api.Txn) []*api.Txn {
+	batch := []*api.Txn{first}
+	for len(batch) < maxTxnsBatch {
+		select {
+		case txn := <-gossips:
+			batch = append(batch, txn)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// sendTxns sends txns to stake-weighted subset of peers.
+func (n *Node) sendTxns(batch []*api.Txn) {
+	req := &api.TxnsBatch{
+		Txns: batch,
+	}
+
+	for _, peer := range n.pushPeers(n.conf.PushFanout) {
+		client, free, fail, err := n.ConnectTo(peer)
+		if err != nil {
+			continue
+		}
+		if !supports(client, capTxns) {
+			free()
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+		_, err = client.SendTxns(ctx, req)
+		cancel()
+
+		if err != nil {
+			n.ConnectFail(peer, err)
+			fail(err)
+			continue
+		}
+		free()
+	}
+}
+
+/*
+ * Utils:
+ */
+
+// evictExpiredTxns removes txns pending longer than TTL.
+// Call it under mempool lock.
+func (n *Node) evictExpiredTxns(now time.Time) {
+	expired := now.Add(-n.conf.MempoolTTL)
+	for idx, txn := range n.mempool.txns {
+		if txn.added.Before(expired) {
+			n.removeTxn(idx)
+		}
+	}
+}
+
+// isReadyTxn returns true if txn is own or nobody has included it for a while.
+func (n *Node) isReadyTxn(txn *pendingTxn, now time.Time) bool {
+	if txn.origin == n.ID {
+		return true
+	}
+	relayed := now.Add(-relayDelay * n.conf.EmitInterval)
+	return txn.added.Before(relayed)
+}
+
+// removeTxn removes txn from mempool if exists.
+// Call it under mempool lock.
+func (n *Node) removeTxn(idx hash.Transaction) {
+	txn := n.mempool.txns[idx]
+	if txn == nil {
+		return
+	}
+
+	delete(n.mempool.txns, idx)
+	n.mempool.origins[txn.origin]--
+	if n.mempool.origins[txn.origin] < 1 {
+		delete(n.mempool.origins, txn.origin)
+	}
+}
+
+// verifyTxn checks txn is signed by its origin.
+func verifyTxn(txn *api.Txn, pub *common.PublicKey) error {
+	r, s, err := crypto.DecodeSignature(txn.Sign)
+	if err != nil {
+		return err
+	}
+
+	h := inter.ExternalTransactionHashOf(txn.Payload)
+	if !pub.Verify(h.Bytes(), r, s) {
+		return fmt.Errorf("txn sign is invalid")
+	}
+	return nil
+}
+
+// signTxn returns txn sign.
+func signTxn(payload []byte, key *common.PrivateKey) string {
+	h := inter.ExternalTransactionHashOf(payload)
+	R, S, err := key.Sign(h.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	return crypto.EncodeSignature(R, S)
+}
@@ -0,0 +1,176 @@
+package posnode
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/ordering"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+func TestMempool(t *testing.T) {
+	store := NewMemStore()
+	node := NewForTests("node", store, nil)
+	node.conf.MempoolSize = 6
+	node.conf.MempoolPerSender = 2
+	defer node.Stop()
+
+	peer1, peer2 := FakePeer("peer1"), FakePeer("peer2")
+
+	t.Run("duplicates", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		assertar.NoError(node.AddExternalTxn([]byte("tx0")))
+		assertar.Error(node.AddExternalTxn([]byte("tx0")))
+		assertar.Error(node.AddExternalTxn(nil))
+		assertar.Equal(1, node.PendingTxnsCount())
+	})
+
+	t.Run("per sender", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		// local clients are not limited by sender
+		assertar.NoError(node.AddExternalTxn([]byte("tx1")))
+		assertar.NoError(node.AddExternalTxn([]byte("tx2")))
+
+		assertar.NoError(node.addPendingTxn(&api.Txn{Payload: []byte("tx3")}, peer1.ID))
+		assertar.NoError(node.addPendingTxn(&api.Txn{Payload: []byte("tx4")}, peer1.ID))
+		assertar.Error(node.addPendingTxn(&api.Txn{Payload: []byte("tx5")}, peer1.ID))
+		assertar.NoError(node.addPendingTxn(&api.Txn{Payload: []byte("tx6")}, peer2.ID))
+		assertar.Equal(6, node.PendingTxnsCount())
+	})
+
+	t.Run("full", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		assertar.Error(node.AddExternalTxn([]byte("tx7-long")))
+		assertar.Error(node.addPendingTxn(&api.Txn{Payload: []byte("tx8")}, peer2.ID))
+		assertar.Equal(6, node.PendingTxnsCount())
+		assertar.True(node.isPendingTxn([]byte("tx3")), "not evicted")
+	})
+
+	t.Run("emission", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		// peer txns wait for their origin
+		assertar.Equal([][]byte{[]byte("tx0"), []byte("tx1"), []byte("tx2")}, node.popReadyTxns(newEventBudget(ordering.Limits{}, 0)))
+		assertar.Nil(node.popReadyTxns(newEventBudget(ordering.Limits{}, 0)))
+
+		node.mempool.Lock()
+		for _, txn := range node.mempool.txns {
+			txn.added = txn.added.Add(-relayDelay * node.conf.EmitInterval)
+		}
+		node.mempool.Unlock()
+		assertar.Equal([][]byte{[]byte("tx3"), []byte("tx4"), []byte("tx6")}, node.popReadyTxns(newEventBudget(ordering.Limits{}, 0)))
+		assertar.Equal(0, node.PendingTxnsCount())
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		for i := 0; i < node.conf.MempoolSize; i++ {
+			assertar.NoError(node.AddExternalTxn([]byte(fmt.Sprintf("ttl%d", i))))
+		}
+		node.mempool.Lock()
+		for _, txn := range node.mempool.txns {
+			txn.added = txn.added.Add(-node.conf.MempoolTTL - time.Second)
+		}
+		node.mempool.Unlock()
+
+		assertar.NoError(node.AddExternalTxn([]byte("tx7")))
+		assertar.Equal(1, node.PendingTxnsCount())
+	})
+
+	t.Run("included", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		e := &inter.Event{
+			Index:                1,
+			Creator:              peer1.ID,
+			ExternalTransactions: [][]byte{[]byte("tx7"), []byte("tx8")},
+		}
+		store.SetEvent(e)
+		store.SetExternalTxnsEvent(e.Hash(), e.ExternalTransactions...)
+		node.dropIncludedTxns(e)
+
+		assertar.Equal(0, node.PendingTxnsCount())
+		assertar.Error(node.AddExternalTxn([]byte("tx8")))
+	})
+}
+
+func TestTxnGossip(t *testing.T) {
+	assertar := assert.New(t)
+
+	// node 1
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, nil)
+	node1.StartService()
+	defer node1.Stop()
+
+	// node 2
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, nil)
+	node2.StartService()
+	defer node2.Stop()
+
+	// connect nodes to each other
+	store1.BootstrapPeers(node2.AsPeer())
+	store2.BootstrapPeers(node1.AsPeer())
+	node2.initPeers()
+
+	node1.StartTxnGossip()
+	defer node1.StopTxnGossip()
+
+	tx := []byte("gossip")
+	if !assertar.NoError(node1.AddExternalTxn(tx)) {
+		return
+	}
+
+	var got bool
+	for i := 0; i < 50 && !got; i++ {
+		time.Sleep(10 * time.Millisecond)
+		got = node2.isPendingTxn(tx)
+	}
+	assertar.True(got, "txn is gossiped")
+
+	// included by node1, so dropped by node2
+	e := node1.EmitEvent()
+	if !assertar.NotNil(e) {
+		return
+	}
+	assertar.Equal([][]byte{tx}, e.ExternalTransactions)
+	node2.onNewEvent(e)
+	assertar.False(node2.isPendingTxn(tx))
+}
+
+func TestTxnSign(t *testing.T) {
+	assertar := assert.New(t)
+
+	key := crypto.GenerateKey()
+	txn := &api.Txn{
+		Payload: []byte("txn"),
+		Sign:    signTxn([]byte("txn"), key),
+	}
+	assertar.NoError(verifyTxn(txn, key.Public()))
+	assertar.Error(verifyTxn(txn, crypto.GenerateKey().Public()), "another origin")
+
+	txn.Payload = []byte("forged")
+	assertar.Error(verifyTxn(txn, key.Public()))
+}
+
+/*
+ * Utils:
+ */
+
+func (n *Node) isPendingTxn(payload []byte) bool {
+	n.mempool.Lock()
+	defer n.mempool.Unlock()
+
+	_, ok := n.mempool.txns[inter.ExternalTransactionHashOf(payload)]
+	return ok
+}
@@ -6,6 +6,9 @@ package posnode
 
 import (
 	hash "github.com/Fantom-foundation/go-lachesis/src/hash"
+	inter "github.com/Fantom-foundation/go-lachesis/src/inter"
+	posposet "github.com/Fantom-foundation/go-lachesis/src/posposet"
+	trie "github.com/Fantom-foundation/go-lachesis/src/trie"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -59,6 +62,20 @@ func (mr *MockConsensusMockRecorder) StakeOf(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StakeOf", reflect.TypeOf((*MockConsensus)(nil).StakeOf), arg0)
 }
 
+// NonceOf mocks base method
+func (m *MockConsensus) NonceOf(arg0 hash.Peer) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonceOf", arg0)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// NonceOf indicates an expected call of NonceOf
+func (mr *MockConsensusMockRecorder) NonceOf(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonceOf", reflect.TypeOf((*MockConsensus)(nil).NonceOf), arg0)
+}
+
 // GetGenesisHash mocks base method
 func (m *MockConsensus) GetGenesisHash() hash.Hash {
 	m.ctrl.T.Helper()
@@ -72,3 +89,158 @@ func (mr *MockConsensusMockRecorder) GetGenesisHash() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenesisHash", reflect.TypeOf((*MockConsensus)(nil).GetGenesisHash))
 }
+
+// GetRules mocks base method
+func (m *MockConsensus) GetRules() posposet.ConsensusRules {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRules")
+	ret0, _ := ret[0].(posposet.ConsensusRules)
+	return ret0
+}
+
+// GetRules indicates an expected call of GetRules
+func (mr *MockConsensusMockRecorder) GetRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRules", reflect.TypeOf((*MockConsensus)(nil).GetRules))
+}
+
+// CurrentEpoch mocks base method
+func (m *MockConsensus) CurrentEpoch() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentEpoch")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// CurrentEpoch indicates an expected call of CurrentEpoch
+func (mr *MockConsensusMockRecorder) CurrentEpoch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentEpoch", reflect.TypeOf((*MockConsensus)(nil).CurrentEpoch))
+}
+
+// TotalStake mocks base method
+func (m *MockConsensus) TotalStake() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalStake")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// TotalStake indicates an expected call of TotalStake
+func (mr *MockConsensusMockRecorder) TotalStake() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalStake", reflect.TypeOf((*MockConsensus)(nil).TotalStake))
+}
+
+// LastBlockN mocks base method
+func (m *MockConsensus) LastBlockN() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastBlockN")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// LastBlockN indicates an expected call of LastBlockN
+func (mr *MockConsensusMockRecorder) LastBlockN() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastBlockN", reflect.TypeOf((*MockConsensus)(nil).LastBlockN))
+}
+
+// LowestBlockN mocks base method
+func (m *MockConsensus) LowestBlockN() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LowestBlockN")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// LowestBlockN indicates an expected call of LowestBlockN
+func (mr *MockConsensusMockRecorder) LowestBlockN() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LowestBlockN", reflect.TypeOf((*MockConsensus)(nil).LowestBlockN))
+}
+
+// GetBlock mocks base method
+func (m *MockConsensus) GetBlock(arg0 uint64) *inter.Block {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlock", arg0)
+	ret0, _ := ret[0].(*inter.Block)
+	return ret0
+}
+
+// GetBlock indicates an expected call of GetBlock
+func (mr *MockConsensusMockRecorder) GetBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockConsensus)(nil).GetBlock), arg0)
+}
+
+// GetCheckpoint mocks base method
+func (m *MockConsensus) GetCheckpoint() *posposet.Checkpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint")
+	ret0, _ := ret[0].(*posposet.Checkpoint)
+	return ret0
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint
+func (mr *MockConsensusMockRecorder) GetCheckpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockConsensus)(nil).GetCheckpoint))
+}
+
+// GetStateNode mocks base method
+func (m *MockConsensus) GetStateNode(arg0 hash.Hash) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStateNode", arg0)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// GetStateNode indicates an expected call of GetStateNode
+func (mr *MockConsensusMockRecorder) GetStateNode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateNode", reflect.TypeOf((*MockConsensus)(nil).GetStateNode), arg0)
+}
+
+// StateSync mocks base method
+func (m *MockConsensus) StateSync(arg0 *posposet.Checkpoint) (*trie.Sync, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateSync", arg0)
+	ret0, _ := ret[0].(*trie.Sync)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateSync indicates an expected call of StateSync
+func (mr *MockConsensusMockRecorder) StateSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateSync", reflect.TypeOf((*MockConsensus)(nil).StateSync), arg0)
+}
+
+// CommitStateSync mocks base method
+func (m *MockConsensus) CommitStateSync(arg0 *trie.Sync) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitStateSync", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitStateSync indicates an expected call of CommitStateSync
+func (mr *MockConsensusMockRecorder) CommitStateSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitStateSync", reflect.TypeOf((*MockConsensus)(nil).CommitStateSync), arg0)
+}
+
+// ApplyCheckpoint mocks base method
+func (m *MockConsensus) ApplyCheckpoint(arg0 *posposet.Checkpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyCheckpoint", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyCheckpoint indicates an expected call of ApplyCheckpoint
+func (mr *MockConsensusMockRecorder) ApplyCheckpoint(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyCheckpoint", reflect.TypeOf((*MockConsensus)(nil).ApplyCheckpoint), arg0)
+}
@@ -31,10 +31,17 @@ type Node struct {
 	peers
 	parents
 	emitter
+	emission
+	mempool
+	forks
+	blockSigns
 	gossip
+	push
+	fastSync
 	downloads
 	discovery
 	builtin
+	record
 
 	logger.Instance
 }
@@ -61,11 +68,15 @@ func New(host string, key *common.PrivateKey, s *Store, c Consensus, conf *Confi
 
 		service:  service{"", listen, nil},
 		connPool: connPool{opts: opts},
+		fastSync: fastSync{pending: conf.FastSync},
+		push:     push{fetchers: make(chan struct{}, maxFetchers)},
 
 		Instance: logger.MakeInstance(),
 	}
 
 	orderThenSave := ordering.EventBuffer(
+		// limits
+		n.eventLimits,
 		// process
 		n.saveNewEvent,
 		// drop
@@ -76,6 +87,8 @@ func New(host string, key *common.PrivateKey, s *Store, c Consensus, conf *Confi
 		func(h hash.Event) *inter.Event {
 			return n.store.GetEvent(h)
 		},
+		// fork
+		n.checkFork,
 	)
 
 	var save sync.Mutex
@@ -95,17 +108,25 @@ func (n *Node) saveNewEvent(e *inter.Event) {
 	n.Debugf("save new event")
 
 	n.store.SetEvent(e)
-	n.store.SetEventHash(e.Creator, e.Index, e.Hash())
+	// fork is saved to keep DAG complete, but index refers to the first event
+	if n.store.GetEventHash(e.Creator, e.Index) == nil {
+		n.store.SetEventHash(e.Creator, e.Index, e.Hash())
+	}
 	n.store.SetPeerHeight(e.Creator, e.Index)
 	// NOTE: doubled txns from evil event could override existing index!
 	// TODO: decision
 	n.store.SetTxnsEvent(e.Hash(), e.Creator, e.InternalTransactions...)
+	n.store.SetExternalTxnsEvent(e.Hash(), e.ExternalTransactions...)
+	n.dropIncludedTxns(e)
+	n.takeForkProofs(e)
 
 	n.pushPotentialParent(e)
 
 	if n.consensus != nil {
 		n.consensus.PushEvent(e.Hash())
 	}
+
+	n.announceEvent(e.Hash())
 }
 
 // GetInternalTxn finds transaction ant its event if exists.
@@ -131,12 +152,16 @@ func (n *Node) Start() {
 	n.StartService()
 	n.StartDiscovery()
 	n.StartGossip(n.conf.GossipThreads)
+	n.StartPush()
+	n.StartTxnGossip()
 	n.StartEventEmission()
 }
 
 // Stop stops all node services.
 func (n *Node) Stop() {
 	n.StopEventEmission()
+	n.StopTxnGossip()
+	n.StopPush()
 	n.StopGossip()
 	n.StopDiscovery()
 	n.StopService()
@@ -72,9 +72,11 @@ func (n *Node) pushPotentialParent(e *inter.Event) {
 		return
 	}
 
-	val := uint64(1)
+	val, epoch, total := uint64(1), uint64(0), uint64(0)
 	if n.consensus != nil {
 		val = n.consensus.StakeOf(e.Creator)
+		epoch = n.consensus.CurrentEpoch()
+		total = n.consensus.TotalStake()
 	}
 
 	n.parents.cache[e.Hash()] = &parent{
@@ -83,6 +85,7 @@ func (n *Node) pushPotentialParent(e *inter.Event) {
 		Value:   val,
 		Last:    true,
 	}
+	n.onEventOf(e.Creator, val, epoch, total)
 
 	prev := n.store.GetEventHash(e.Creator, e.Index-1)
 	if prev != nil && n.parents.cache[*prev] != nil {
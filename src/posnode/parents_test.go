@@ -11,6 +11,7 @@ import (
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 )
 
 func TestParentSelection(t *testing.T) {
@@ -45,6 +46,18 @@ func testParentSelection(t *testing.T, dsc, schema string) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 		consensus := NewMockConsensus(ctrl)
+		consensus.EXPECT().
+			CurrentEpoch().
+			Return(uint64(0)).
+			AnyTimes()
+		consensus.EXPECT().
+			TotalStake().
+			Return(uint64(0)).
+			AnyTimes()
+		consensus.EXPECT().
+			GetRules().
+			Return(posposet.DefaultRules()).
+			AnyTimes()
 
 		store := NewMemStore()
 		node := NewForTests(dsc, store, consensus)
@@ -28,6 +28,15 @@ type (
 		ID   hash.Peer
 		Busy bool
 		Host *hostAttr
+
+		Score  float64
+		Scored time.Time
+
+		Crawled time.Time
+
+		// persistent
+		BannedUntil time.Time
+		Bans        uint32
 	}
 )
 
@@ -5,6 +5,9 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 )
 
@@ -17,6 +20,7 @@ type peers struct {
 
 	sync.RWMutex
 	save func()
+	load func(*peerAttr)
 }
 
 func (n *Node) initPeers() {
@@ -30,6 +34,7 @@ func (n *Node) initPeers() {
 	n.peers.save = func() {
 		n.store.SetTopPeers(n.peers.top)
 	}
+	n.peers.load = n.loadBan
 
 	n.peers.ids = make(map[hash.Peer]*peerAttr, n.conf.TopPeersCount)
 	n.peers.hosts = make(map[string]*hostAttr, n.conf.TopPeersCount*4)
@@ -55,6 +60,9 @@ func (pp *peers) attrByID(id hash.Peer) *peerAttr {
 			ID:   id,
 			Host: &hostAttr{},
 		}
+		if pp.load != nil {
+			pp.load(attr)
+		}
 		pp.ids[id] = attr
 	}
 	return attr
@@ -106,14 +114,10 @@ func (n *Node) ConnectOK(p *Peer) {
 		return
 	}
 
-	stored := n.store.GetPeer(p.ID)
-	if stored == nil {
+	// record is signed by peer, so its host is in address book only
+	if n.store.GetWirePeer(p.ID) == nil {
 		return
 	}
-	if stored.Host != p.Host {
-		stored.Host = p.Host
-		n.store.SetPeer(stored)
-	}
 
 	for _, exist := range n.peers.top {
 		if p.ID == exist {
@@ -137,6 +141,10 @@ func (n *Node) ConnectFail(p *Peer, err error) {
 		return
 	}
 
+	if status.Code(err) == codes.DeadlineExceeded {
+		n.scorePeer(p.ID, scoreTimeout)
+	}
+
 	n.peers.unordered = true
 }
 
@@ -194,12 +202,16 @@ func (n *Node) NextForGossip() *Peer {
 		}
 	}
 
-	// return first no busy
+	// return first no busy and not banned
+	now := time.Now()
 	for _, candidate := range n.peers.top {
 		attrs := n.peers.attrByID(candidate)
-		if !attrs.Busy {
+		if !attrs.Busy && !attrs.banned(now) {
 			attrs.Busy = true
 			peer := n.store.GetPeer(candidate)
+			if host := n.bestPeerAddr(candidate); peer != nil && host != "" {
+				peer.Host = host
+			}
 			return peer
 		}
 	}
@@ -241,6 +253,7 @@ func (n *Node) updatePeerAttrs(p *Peer, isSuccess bool) bool {
 	}
 
 	peer.Host = host
+	n.markPeerAddr(p, isSuccess)
 
 	return true
 }
@@ -0,0 +1,257 @@
+package posnode
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+const (
+	// maxAnnounce is a max count of event hashes per announcement.
+	maxAnnounce = 64
+	// maxFetchers is a max count of concurrent downloads of announced events.
+	maxFetchers = 8
+)
+
+// push is a process of announcing new events to peers
+// and a pool of fetching announced ones.
+type push struct {
+	announces chan hash.Event
+	fetchers  chan struct{}
+	done      chan struct{}
+
+	wg sync.WaitGroup
+	sync.RWMutex
+}
+
+// StartPush starts announcing of new events if push gossip is enabled.
+func (n *Node) StartPush() {
+	if !n.conf.PushGossip || n.push.done != nil {
+		return
+	}
+
+	n.initClient()
+	n.initPeers()
+
+	announces := make(chan hash.Event, maxAnnounce*4) // magic buffer size.
+	n.push.Lock()
+	n.push.announces = announces
+	n.push.Unlock()
+
+	n.push.done = make(chan struct{})
+	done := n.push.done
+
+	n.push.wg.Add(1)
+	go func() {
+		defer n.push.wg.Done()
+		for {
+			select {
+			case h := <-announces:
+				n.announce(n.collectAnnounces(announces, h))
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	n.Info("push gossip started")
+}
+
+// StopPush stops announcing of new events.
+func (n *Node) StopPush() {
+	if n.push.done == nil {
+		return
+	}
+
+	close(n.push.done)
+	n.push.done = nil
+	n.push.wg.Wait()
+
+	n.push.Lock()
+	n.push.announces = nil
+	n.push.Unlock()
+
+	n.Info("push gossip stopped")
+}
+
+// announceEvent queues event hash to announce if push is started.
+func (n *Node) announceEvent(h hash.Event) {
+	n.push.RLock()
+	defer n.push.RUnlock()
+
+	if n.push.announces == nil {
+		return
+	}
+
+	select {
+	case n.push.announces <- h:
+	default:
+		n.Warn("push.announces queue is full, so skipped")
+	}
+}
+
+// collectAnnounces returns queued hashes as a batch.
+func (n *Node) collectAnnounces(announces chan hash.Event, first hash.Event) hash.EventsSlice {
+	batch := hash.EventsSlice{first}
+	for len(batch) < maxAnnounce {
+		select {
+		case h := <-announces:
+			batch = append(batch, h)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// announce sends event hashes to stake-weighted subset of peers.
+func (n *Node) announce(batch hash.EventsSlice) {
+	req := &api.Announcement{
+		Hashes: make([][]byte, len(batch)),
+	}
+	for i, h := range batch {
+		req.Hashes[i] = h.Bytes()
+	}
+
+	for _, peer := range n.pushPeers(n.conf.PushFanout) {
+		client, free, fail, err := n.ConnectTo(peer)
+		if err != nil {
+			continue
+		}
+		if !supports(client, capAnnounce) {
+			free()
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+		_, err = client.AnnounceEvents(ctx, req)
+		cancel()
+
+		if err != nil {
+			n.ConnectFail(peer, err)
+			fail(err)
+			continue
+		}
+		free()
+	}
+}
+
+// fetchAnnounced downloads announced events from peer in background
+// if there is a free fetcher, otherwise the announcement is skipped.
+func (n *Node) fetchAnnounced(peer *Peer, events hash.Events) {
+	select {
+	case n.push.fetchers <- struct{}{}:
+	default:
+		n.unlockDownloaded(events)
+		n.Warnf("all of %d fetchers are busy, so announcement of %s skipped", maxFetchers, peer.ID.String())
+		return
+	}
+
+	go func() {
+		defer func() { <-n.push.fetchers }()
+		n.downloadAnnounced(peer, events)
+	}()
+}
+
+// downloadAnnounced downloads announced events from peer.
+func (n *Node) downloadAnnounced(peer *Peer, events hash.Events) {
+	defer n.unlockDownloaded(events)
+
+	client, free, fail, err := n.ConnectTo(peer)
+	if err != nil {
+		return
+	}
+	defer free()
+
+	parents := hash.Events{}
+	for e := range events {
+		req := &api.EventRequest{
+			Hash: e.Bytes(),
+		}
+
+		event, err := n.downloadEvent(client, peer, req)
+		if err != nil {
+			fail(err)
+			return
+		}
+		if event == nil {
+			return
+		}
+
+		parents.Add(event.Parents.Slice()...)
+	}
+	n.ConnectOK(peer)
+
+	n.checkParents(client, peer, parents)
+}
+
+// pushPeers returns random subset of top peers, chance is proportional to stake.
+// Peers without stake have the minimal chance, so they get announcements too.
+func (n *Node) pushPeers(count int) []*Peer {
+	candidates := n.peers.Snapshot()
+
+	var total uint64
+	stakes := make([]uint64, len(candidates))
+	for i, id := range candidates {
+		if n.PeerBanned(id) {
+			continue
+		}
+		stakes[i] = 1
+		if n.consensus != nil {
+			if stake := n.consensus.StakeOf(id); stake > 1 {
+				stakes[i] = stake
+			}
+		}
+		if total+stakes[i] < total {
+			stakes[i] = math.MaxUint64 - total
+		}
+		total += stakes[i]
+	}
+
+	res := make([]*Peer, 0, count)
+	for len(res) < count && total > 0 {
+		x := randUint64n(total)
+		for i, stake := range stakes {
+			if x >= stake {
+				x -= stake
+				continue
+			}
+			// without replacement
+			total -= stake
+			stakes[i] = 0
+			peer := n.store.GetPeer(candidates[i])
+			if peer == nil {
+				break
+			}
+			// record host may be empty, so seen address is preferred
+			if hosts := n.PeerAddrs(peer.ID); len(hosts) > 0 {
+				peer.Host = hosts[0]
+			}
+			if peer.Host != "" {
+				res = append(res, peer)
+			}
+			break
+		}
+	}
+
+	return res
+}
+
+/*
+ * Utils:
+ */
+
+// randUint64n returns uniform random number in [0,n).
+func randUint64n(n uint64) uint64 {
+	// the greatest value of the range which is a multiple of n
+	max := math.MaxUint64 - (math.MaxUint64%n+1)%n
+	for {
+		if x := rand.Uint64(); x <= max {
+			return x % n
+		}
+	}
+}
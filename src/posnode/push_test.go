@@ -0,0 +1,136 @@
+package posnode
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestPushGossip(t *testing.T) {
+	assertar := assert.New(t)
+
+	// node 1
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, nil)
+	node1.conf.PushGossip = true
+	node1.StartService()
+	defer node1.Stop()
+
+	// node 2
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, nil)
+	node2.StartService()
+	defer node2.Stop()
+
+	// connect nodes to each other
+	store1.BootstrapPeers(node2.AsPeer())
+	store2.BootstrapPeers(node1.AsPeer())
+	node2.initPeers()
+
+	node1.StartPush()
+	defer node1.StopPush()
+
+	e := node1.EmitEvent()
+	if !assertar.NotNil(e) {
+		return
+	}
+
+	// no pull gossip, event is announced
+	var got *hash.Event
+	for i := 0; i < 50 && got == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		got = store2.GetEventHash(node1.ID, e.Index)
+	}
+	if !assertar.NotNil(got, "event is pushed") {
+		return
+	}
+	assertar.Equal(e.Hash(), *got)
+}
+
+func TestPushPeers(t *testing.T) {
+	assertar := assert.New(t)
+
+	store := NewMemStore()
+	node := NewForTests("node", store, nil)
+	defer node.Stop()
+
+	peers := make([]*Peer, 5)
+	for i := range peers {
+		peers[i] = FakePeer(fmt.Sprintf("host%d", i))
+	}
+	store.BootstrapPeers(peers...)
+	node.initPeers()
+
+	got := node.pushPeers(3)
+	assertar.Len(got, 3)
+
+	uniq := make(map[hash.Peer]struct{})
+	for _, p := range got {
+		uniq[p.ID] = struct{}{}
+	}
+	assertar.Len(uniq, 3, "peers are not repeated")
+
+	assertar.Len(node.pushPeers(10), len(peers), "all peers at most")
+}
+
+func TestPushPeersStake(t *testing.T) {
+	assertar := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	peers := make([]*Peer, 4)
+	for i := range peers {
+		peers[i] = FakePeer(fmt.Sprintf("host%d", i))
+	}
+	stakes := map[hash.Peer]uint64{
+		peers[0].ID: 0,
+		peers[1].ID: 1,
+		peers[2].ID: 9,
+		peers[3].ID: 0,
+	}
+
+	consensus := NewMockConsensus(ctrl)
+	consensus.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+	consensus.EXPECT().
+		StakeOf(gomock.Any()).
+		DoAndReturn(func(id hash.Peer) uint64 {
+			return stakes[id]
+		}).
+		AnyTimes()
+
+	store := NewMemStore()
+	node := NewForTests("node", store, consensus)
+	defer node.Stop()
+
+	store.BootstrapPeers(peers...)
+	node.initPeers()
+
+	// peers without stake are chosen too
+	got := node.pushPeers(len(peers))
+	assertar.Len(got, len(peers))
+
+	// chance is proportional to stake
+	counts := make(map[hash.Peer]int)
+	for i := 0; i < 1000; i++ {
+		for _, p := range node.pushPeers(1) {
+			counts[p.ID]++
+		}
+	}
+	assertar.True(counts[peers[2].ID] > 3*counts[peers[1].ID], "%d vs %d", counts[peers[2].ID], counts[peers[1].ID])
+	assertar.True(counts[peers[0].ID] > 0, "minimal chance without stake")
+
+	// total stake over int64
+	stakes[peers[1].ID] = math.MaxUint64 / 3
+	stakes[peers[2].ID] = math.MaxUint64 / 3
+	assertar.Len(node.pushPeers(len(peers)), len(peers))
+}
@@ -0,0 +1,154 @@
+package posnode
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// protocolVersions are versions of node protocol supported.
+var protocolVersions = []uint32{1}
+
+// record is a self-signed node record.
+type record struct {
+	self *api.PeerInfo
+
+	sync.Mutex
+}
+
+// SelfRecord returns self-signed node record.
+// Sequence number is increased when record changes.
+func (n *Node) SelfRecord() *api.PeerInfo {
+	n.record.Lock()
+	defer n.record.Unlock()
+
+	if n.record.self == nil {
+		n.record.self = n.makeSelfRecord()
+	}
+
+	return proto.Clone(n.record.self).(*api.PeerInfo)
+}
+
+// setPeerRecord stores record of peer if it is newer than stored one.
+// It returns error if record is not valid.
+func (n *Node) setPeerRecord(info *api.PeerInfo) error {
+	if err := verifyRecord(info); err != nil {
+		return err
+	}
+
+	id := hash.HexToPeer(info.ID)
+	if id == n.ID {
+		return nil
+	}
+
+	n.record.Lock()
+	defer n.record.Unlock()
+
+	if prev := n.store.GetWirePeer(id); prev != nil && prev.Seq >= info.Seq {
+		return nil
+	}
+
+	n.store.SetWirePeer(id, info)
+	return nil
+}
+
+// makeSelfRecord returns stored self record if it is actual or signs the next one.
+func (n *Node) makeSelfRecord() *api.PeerInfo {
+	info := &api.PeerInfo{
+		ID:       n.ID.Hex(),
+		PubKey:   n.pub.Bytes(),
+		Host:     n.host,
+		Versions: protocolVersions,
+	}
+	// node may not know its public address, peers observe it then
+	if n.host != "" {
+		info.Addrs = []string{n.host}
+	}
+
+	// wall-clock seeds the sequence, so it grows after the store is wiped
+	seq := uint64(time.Now().Unix())
+
+	var prev *api.PeerInfo
+	if n.store != nil {
+		prev = n.store.GetWirePeer(n.ID)
+	}
+	if prev != nil && verifyRecord(prev) == nil {
+		info.Seq, info.Sign = prev.Seq, prev.Sign
+		if proto.Equal(prev, info) {
+			return prev
+		}
+		if prev.Seq >= seq {
+			seq = prev.Seq + 1
+		}
+	}
+	info.Seq = seq
+
+	info.Sign = signRecord(info, n.key)
+	if n.store != nil {
+		n.store.SetWirePeer(n.ID, info)
+	}
+
+	return info
+}
+
+/*
+ * Utils:
+ */
+
+// verifyRecord checks record is signed by the peer key.
+// Host may be empty if the peer does not know its public address.
+func verifyRecord(info *api.PeerInfo) error {
+	if info == nil {
+		return errors.New("record is empty")
+	}
+
+	if hash.PeerOfPubkeyBytes(info.PubKey) != hash.HexToPeer(info.ID) {
+		return errors.New("record is not of the peer key")
+	}
+	pub := common.BytesToPubkey(info.PubKey)
+	if pub == nil || pub.X == nil {
+		return errors.New("record has invalid key")
+	}
+
+	r, s, err := crypto.DecodeSignature(info.Sign)
+	if err != nil {
+		return err
+	}
+
+	if !pub.Verify(recordHash(info).Bytes(), r, s) {
+		return errors.New("record sign is invalid")
+	}
+
+	return nil
+}
+
+// signRecord returns record sign.
+func signRecord(info *api.PeerInfo, key *common.PrivateKey) string {
+	R, S, err := key.Sign(recordHash(info).Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	return crypto.EncodeSignature(R, S)
+}
+
+// recordHash returns hash of record without sign.
+func recordHash(info *api.PeerInfo) hash.Hash {
+	unsigned := proto.Clone(info).(*api.PeerInfo)
+	unsigned.Sign = ""
+
+	var pbf proto.Buffer
+	pbf.SetDeterministic(true)
+	if err := pbf.Marshal(unsigned); err != nil {
+		panic(err)
+	}
+
+	return hash.Of(pbf.Bytes())
+}
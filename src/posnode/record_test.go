@@ -0,0 +1,90 @@
+package posnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/network"
+)
+
+func TestSelfRecord(t *testing.T) {
+	assertar := assert.New(t)
+
+	key := crypto.GenerateKey()
+	store := NewMemStore()
+
+	start := uint64(time.Now().Unix())
+	node := New("host1", key, store, nil, nil, network.FakeListener)
+	rec1 := node.SelfRecord()
+	assertar.NoError(verifyRecord(rec1))
+	assertar.True(rec1.Seq >= start, "seq is seeded by wall-clock")
+	assertar.Equal(protocolVersions, rec1.Versions)
+
+	// the same record after restart
+	node = New("host1", key, store, nil, nil, network.FakeListener)
+	assertar.True(proto.Equal(rec1, node.SelfRecord()))
+
+	// the next record after host change
+	node = New("host2", key, store, nil, nil, network.FakeListener)
+	rec2 := node.SelfRecord()
+	assertar.NoError(verifyRecord(rec2))
+	assertar.True(rec2.Seq > rec1.Seq)
+	assertar.Equal("host2", rec2.Host)
+
+	// the next record after store is wiped
+	node = New("host1", key, NewMemStore(), nil, nil, network.FakeListener)
+	rec3 := node.SelfRecord()
+	assertar.True(rec3.Seq >= rec1.Seq)
+
+	// public address is unknown
+	node = New("", key, store, nil, nil, network.FakeListener)
+	rec4 := node.SelfRecord()
+	assertar.NoError(verifyRecord(rec4))
+	assertar.True(rec4.Seq > rec2.Seq)
+	assertar.Empty(rec4.Addrs)
+}
+
+func TestSetPeerRecord(t *testing.T) {
+	store := NewMemStore()
+	node := NewForTests("node", store, nil)
+
+	peerKey := crypto.GenerateKey()
+	peer := New("host1", peerKey, NewMemStore(), nil, nil, network.FakeListener)
+	rec1 := peer.SelfRecord()
+
+	t.Run("valid", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		assertar.NoError(node.setPeerRecord(rec1))
+		assertar.True(proto.Equal(rec1, store.GetWirePeer(peer.ID)))
+	})
+
+	t.Run("rewritten host", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		forged := peer.SelfRecord()
+		forged.Host = "attacker"
+		forged.Seq++
+
+		assertar.Error(node.setPeerRecord(forged))
+		assertar.True(proto.Equal(rec1, store.GetWirePeer(peer.ID)))
+	})
+
+	t.Run("newer and older", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		peer.record.self = nil
+		peer.host = "host2"
+		rec2 := peer.SelfRecord()
+
+		assertar.NoError(node.setPeerRecord(rec2))
+		assertar.True(proto.Equal(rec2, store.GetWirePeer(peer.ID)))
+
+		assertar.NoError(node.setPeerRecord(rec1))
+		assertar.True(proto.Equal(rec2, store.GetWirePeer(peer.ID)))
+	})
+}
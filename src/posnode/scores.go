@@ -0,0 +1,145 @@
+package posnode
+
+import (
+	"math"
+	"time"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// Peer score changes.
+const (
+	scoreInvalidSign = -50.0
+	scoreBadResponse = -20.0
+	scoreTimeout     = -5.0
+	scoreSlow        = -1.0
+	scoreFast        = 1.0
+	scoreUsefulEvent = 1.0
+
+	// maxBanShift limits doubling of ban period.
+	maxBanShift = 6
+)
+
+// ScorePeer changes peer score.
+// Peer is banned if the score falls below threshold.
+func (n *Node) ScorePeer(id hash.Peer, delta float64) {
+	n.peers.Lock()
+	defer n.peers.Unlock()
+
+	n.scorePeer(id, delta)
+}
+
+// PeerScore returns current peer score.
+func (n *Node) PeerScore(id hash.Peer) float64 {
+	n.peers.Lock()
+	defer n.peers.Unlock()
+
+	if n.peers.ids == nil {
+		return 0
+	}
+
+	attr := n.peers.attrByID(id)
+	if attr == nil {
+		return 0
+	}
+	return attr.score(time.Now(), n.conf.ScoreHalfLife)
+}
+
+// PeerBanned returns true if peer is banned now.
+func (n *Node) PeerBanned(id hash.Peer) bool {
+	n.peers.Lock()
+	defer n.peers.Unlock()
+
+	now := time.Now()
+
+	if attr := n.peers.ids[id]; attr != nil {
+		return attr.banned(now)
+	}
+
+	if n.store == nil {
+		return false
+	}
+	ban := n.store.GetPeerBan(id)
+	return ban != nil && now.Before(time.Unix(0, ban.Until))
+}
+
+// scoreLatency scores peer by response time.
+func (n *Node) scoreLatency(p *Peer, latency time.Duration) {
+	switch {
+	case latency < n.conf.ClientTimeout/10:
+		n.ScorePeer(p.ID, scoreFast)
+	case latency > n.conf.ClientTimeout/2:
+		n.ScorePeer(p.ID, scoreSlow)
+	}
+}
+
+// scorePeer changes peer score and bans it if the score falls below threshold.
+// Ban period doubles for each next ban of peer.
+// It is not safe for concurrent use, so lock peers.
+func (n *Node) scorePeer(id hash.Peer, delta float64) {
+	if n.peers.ids == nil {
+		return
+	}
+
+	attr := n.peers.attrByID(id)
+	if attr == nil {
+		return
+	}
+
+	now := time.Now()
+	attr.Score = attr.score(now, n.conf.ScoreHalfLife) + delta
+	attr.Scored = now
+	n.peers.unordered = true
+
+	if attr.Score >= n.conf.BanThreshold || attr.banned(now) {
+		return
+	}
+
+	shift := attr.Bans
+	if shift > maxBanShift {
+		shift = maxBanShift
+	}
+	period := n.conf.BanTimeout << shift
+
+	attr.Bans++
+	attr.BannedUntil = now.Add(period)
+	attr.Score = 0
+
+	n.store.SetPeerBan(id, &api.PeerBan{
+		Until: attr.BannedUntil.UnixNano(),
+		Count: attr.Bans,
+	})
+
+	n.Warnf("peer %s is banned for %s", id.String(), period)
+}
+
+// loadBan restores peer ban from store.
+func (n *Node) loadBan(attr *peerAttr) {
+	ban := n.store.GetPeerBan(attr.ID)
+	if ban == nil {
+		return
+	}
+
+	attr.BannedUntil = time.Unix(0, ban.Until)
+	attr.Bans = ban.Count
+}
+
+/*
+ * peerAttr's methods:
+ */
+
+// score returns the score decayed to zero by time.
+func (attr *peerAttr) score(now time.Time, halfLife time.Duration) float64 {
+	if attr.Score == 0 || halfLife <= 0 {
+		return attr.Score
+	}
+
+	elapsed := now.Sub(attr.Scored)
+	return attr.Score * math.Pow(0.5, float64(elapsed)/float64(halfLife))
+}
+
+// banned returns true if peer is banned at the time.
+func (attr *peerAttr) banned(now time.Time) bool {
+	return now.Before(attr.BannedUntil)
+}
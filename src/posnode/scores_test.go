@@ -0,0 +1,85 @@
+package posnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerScore(t *testing.T) {
+	assertar := assert.New(t)
+
+	store := NewMemStore()
+	node := NewForTests("node", store, nil)
+	node.initPeers()
+	defer node.Stop()
+
+	peer := FakePeer("host")
+
+	node.ScorePeer(peer.ID, scoreUsefulEvent)
+	node.ScorePeer(peer.ID, scoreUsefulEvent)
+	assertar.InDelta(2*scoreUsefulEvent, node.PeerScore(peer.ID), 0.01)
+
+	// decay
+	node.peers.ids[peer.ID].Scored = time.Now().Add(-node.conf.ScoreHalfLife)
+	assertar.InDelta(scoreUsefulEvent, node.PeerScore(peer.ID), 0.01)
+}
+
+func TestPeerBan(t *testing.T) {
+	store := NewMemStore()
+	node := NewForTests("node", store, nil)
+	defer node.Stop()
+
+	peer := FakePeer("host")
+	store.BootstrapPeers(peer)
+	node.initPeers()
+
+	t.Run("not banned", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		node.ScorePeer(peer.ID, scoreBadResponse)
+		assertar.False(node.PeerBanned(peer.ID))
+		assertar.Equal(peer, node.NextForGossip())
+		node.FreePeer(peer)
+	})
+
+	t.Run("banned", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		for !node.PeerBanned(peer.ID) {
+			node.ScorePeer(peer.ID, scoreInvalidSign)
+		}
+		assertar.Nil(node.NextForGossip())
+
+		attr := node.peers.ids[peer.ID]
+		assertar.Equal(uint32(1), attr.Bans)
+		assertar.WithinDuration(time.Now().Add(node.conf.BanTimeout), attr.BannedUntil, time.Second)
+	})
+
+	t.Run("ban persists", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		restarted := NewForTests("node", store, nil)
+		defer restarted.Stop()
+
+		assertar.True(restarted.PeerBanned(peer.ID))
+		restarted.initPeers()
+		assertar.True(restarted.PeerBanned(peer.ID))
+		assertar.Nil(restarted.NextForGossip())
+	})
+
+	t.Run("ban expires and doubles", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		attr := node.peers.ids[peer.ID]
+		attr.BannedUntil = time.Now()
+		assertar.False(node.PeerBanned(peer.ID))
+
+		for !node.PeerBanned(peer.ID) {
+			node.ScorePeer(peer.ID, scoreInvalidSign)
+		}
+		assertar.Equal(uint32(2), attr.Bans)
+		assertar.WithinDuration(time.Now().Add(2*node.conf.BanTimeout), attr.BannedUntil, time.Second)
+	})
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
@@ -34,8 +37,17 @@ func (n *Node) StartService() {
 		genesis = n.consensus.GetGenesisHash()
 	}
 
+	var creds credentials.TransportCredentials
+	if n.conf.TLS {
+		var err error
+		creds, err = api.ServerTLS(n.key, genesis)
+		if err != nil {
+			n.Fatal(err)
+		}
+	}
+
 	bind := n.NetAddrOf(n.host)
-	_, n.Addr, n.stopServer = api.StartService(bind, n.key, genesis, n, n.Infof, n.service.listen)
+	_, n.Addr, n.stopServer = api.StartService(bind, n.key, genesis, creds, n, n.conf.ServiceLimits, n.Infof, n.service.listen)
 }
 
 // StopService stops node service.
@@ -53,7 +65,7 @@ func (n *Node) StopService() {
 
 // SyncEvents returns their known event heights excluding heights from request.
 func (n *Node) SyncEvents(ctx context.Context, req *api.KnownEvents) (*api.KnownEvents, error) {
-	if err := checkSource(ctx); err != nil {
+	if err := n.checkSource(ctx); err != nil {
 		return nil, err
 	}
 
@@ -74,12 +86,17 @@ func (n *Node) SyncEvents(ctx context.Context, req *api.KnownEvents) (*api.Known
 	// TODO: should we remember other node's knowns for future request?
 	// to_download := PeersHeightsDiff(req.Lasts, known)
 	diff := PeersHeightsDiff(knownLasts, req.Lasts)
-	return &api.KnownEvents{Lasts: diff}, nil
+	resp := &api.KnownEvents{Lasts: diff}
+	// older events could be pruned already
+	if n.consensus != nil {
+		resp.LowestBlockN = n.consensus.LowestBlockN()
+	}
+	return resp, nil
 }
 
 // GetEvent returns requested event.
 func (n *Node) GetEvent(ctx context.Context, req *api.EventRequest) (*wire.Event, error) {
-	if err := checkSource(ctx); err != nil {
+	if err := n.checkSource(ctx); err != nil {
 		return nil, err
 	}
 
@@ -108,9 +125,155 @@ func (n *Node) GetEvent(ctx context.Context, req *api.EventRequest) (*wire.Event
 	return event, nil
 }
 
+// StreamEvents sends requested events in parent-first order
+// until the byte budget is spent (at least one event is sent).
+func (n *Node) StreamEvents(req *api.EventsRequest, stream api.Node_StreamEventsServer) error {
+	ctx := stream.Context()
+	if err := n.checkSource(ctx); err != nil {
+		return err
+	}
+
+	// food for discovery
+	host := api.GrpcPeerHost(ctx)
+	n.CheckPeerIsKnown(host, nil)
+
+	budget := n.conf.SyncBatchBytes
+	if req.MaxBytes > 0 && req.MaxBytes < budget {
+		budget = req.MaxBytes
+	}
+
+	// the next event of each creator
+	heads := make(map[string]*wire.Event, len(req.Heights))
+	next := func(creator string, index uint64) {
+		delete(heads, creator)
+		if interval := req.Heights[creator]; interval == nil || index > interval.To {
+			return
+		}
+		h := n.store.GetEventHash(hash.HexToPeer(creator), index)
+		if h == nil {
+			return
+		}
+		if e := n.store.GetWireEvent(*h); e != nil {
+			heads[creator] = e
+		}
+	}
+	for creator, interval := range req.Heights {
+		if interval != nil {
+			next(creator, interval.From)
+		}
+	}
+
+	var sent uint64
+	for len(heads) > 0 {
+		// parents have lower Lamport time
+		var event *wire.Event
+		for _, e := range heads {
+			if event == nil || e.LamportTime < event.LamportTime {
+				event = e
+			}
+		}
+
+		size := uint64(proto.Size(event))
+		if sent > 0 && sent+size > budget {
+			break
+		}
+
+		if err := ctx.Err(); err != nil {
+			return status.Error(codes.Canceled, err.Error())
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		sent += size
+
+		next(event.Creator, event.Index+1)
+	}
+
+	return nil
+}
+
+// AnnounceEvents takes hashes of new events and downloads unknown ones from the announcer.
+func (n *Node) AnnounceEvents(ctx context.Context, req *api.Announcement) (*empty.Empty, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(req.Hashes) > maxAnnounce {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("too many events announced, max is %d", maxAnnounce))
+	}
+
+	// food for discovery
+	host := api.GrpcPeerHost(ctx)
+	source := api.GrpcPeerID(ctx)
+	peer := n.store.GetPeer(source)
+	if peer == nil {
+		n.CheckPeerIsKnown(host, &source)
+		return &empty.Empty{}, nil
+	}
+	// peer may not know its public address
+	if peer.Host == "" {
+		peer.Host = host
+	}
+
+	announced := hash.Events{}
+	for _, buf := range req.Hashes {
+		announced.Add(hash.BytesToEventHash(buf))
+	}
+	// skips known and downloading events, so announcements are not amplified
+	toDownload := n.lockNotDownloaded(announced)
+	if len(toDownload) > 0 {
+		n.fetchAnnounced(peer, toDownload)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// SendTxns takes pending txns relayed by peer to include into events.
+func (n *Node) SendTxns(ctx context.Context, req *api.TxnsBatch) (*empty.Empty, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(req.Txns) > maxTxnsBatch {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("too many txns sent, max is %d", maxTxnsBatch))
+	}
+
+	// food for discovery
+	host := api.GrpcPeerHost(ctx)
+	source := api.GrpcPeerID(ctx)
+	if n.store.GetPeer(source) == nil {
+		n.CheckPeerIsKnown(host, &source)
+		return &empty.Empty{}, nil
+	}
+
+	for _, txn := range req.Txns {
+		// txn is limited by its origin, so origin should be known to check the sign
+		origin := hash.HexToPeer(txn.Origin)
+		if origin == n.ID {
+			continue
+		}
+		peer := n.store.GetPeer(origin)
+		if peer == nil {
+			n.CheckPeerIsKnown(host, &origin)
+			continue
+		}
+		if err := verifyTxn(txn, peer.PubKey); err != nil {
+			n.ScorePeer(source, scoreInvalidSign)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// known and included txns are not gossiped again
+		if err := n.addPendingTxn(txn, origin); err != nil {
+			n.Debugf("txn of %s from %s skipped: %s", origin.String(), source.String(), err)
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // GetPeerInfo returns requested peer info.
 func (n *Node) GetPeerInfo(ctx context.Context, req *api.PeerRequest) (*api.PeerInfo, error) {
-	if err := checkSource(ctx); err != nil {
+	if err := n.checkSource(ctx); err != nil {
 		return nil, err
 	}
 
@@ -127,18 +290,79 @@ func (n *Node) GetPeerInfo(ctx context.Context, req *api.PeerRequest) (*api.Peer
 	}
 
 	if id == n.ID { // self
-		info := n.AsPeer()
-		return info.ToWire(), nil
+		return n.SelfRecord(), nil
 	}
 
+	// not self-signed records (bootstrap) are not for relay
 	info := n.store.GetWirePeer(id)
-	if info == nil {
+	if verifyRecord(info) != nil {
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("peer not found: %s", req.PeerID))
 	}
 
 	return info, nil
 }
 
+// GetCheckpoint returns the last certified block for fast sync.
+func (n *Node) GetCheckpoint(ctx context.Context, req *empty.Empty) (*api.Checkpoint, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	if n.consensus == nil {
+		return nil, status.Error(codes.Unavailable, "no consensus")
+	}
+
+	checkpoint := n.consensus.GetCheckpoint()
+	if checkpoint == nil {
+		return nil, status.Error(codes.NotFound, "no certified block")
+	}
+
+	return checkpointToWire(checkpoint), nil
+}
+
+// GetStateNodes returns requested nodes of balances trie.
+// Unknown nodes are empty.
+func (n *Node) GetStateNodes(ctx context.Context, req *api.StateRequest) (*api.StateNodes, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	if n.consensus == nil {
+		return nil, status.Error(codes.Unavailable, "no consensus")
+	}
+
+	if len(req.Hashes) > maxStateNodes {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("too many nodes requested, max is %d", maxStateNodes))
+	}
+
+	resp := &api.StateNodes{
+		Nodes: make([][]byte, len(req.Hashes)),
+	}
+	for i, buf := range req.Hashes {
+		resp.Nodes[i] = n.consensus.GetStateNode(hash.FromBytes(buf))
+	}
+
+	return resp, nil
+}
+
+// GetPeers returns random sample of known recently reachable peers.
+func (n *Node) GetPeers(ctx context.Context, req *api.PeersRequest) (*api.PeerList, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	// food for discovery
+	host := api.GrpcPeerHost(ctx)
+	n.CheckPeerIsKnown(host, nil)
+
+	max := int(req.Max)
+	if max < 1 || max > maxPeersExchange {
+		max = maxPeersExchange
+	}
+
+	return n.reachablePeers(max, api.GrpcPeerID(ctx)), nil
+}
+
 /*
  * Utils:
  */
@@ -154,10 +378,13 @@ func PeersHeightsDiff(all, excepts map[string]uint64) (res map[string]uint64) {
 	return
 }
 
-func checkSource(ctx context.Context) error {
+func (n *Node) checkSource(ctx context.Context) error {
 	source := api.GrpcPeerID(ctx)
 	if source.IsEmpty() {
 		return status.Error(codes.Unauthenticated, "unknown peer")
 	}
+	if n.PeerBanned(source) {
+		return status.Error(codes.PermissionDenied, "peer is banned")
+	}
 	return nil
 }
@@ -2,12 +2,16 @@ package posnode
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
 )
 
@@ -30,8 +34,8 @@ func TestGetPeerInfo(t *testing.T) {
 	t.Run("existing peer", func(t *testing.T) {
 		assertar := assert.New(t)
 
-		peer := FakePeer("unreachable")
-		store.SetPeer(peer)
+		peer := NewForTests("unreachable", nil, nil)
+		store.SetWirePeer(peer.ID, peer.SelfRecord())
 
 		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
 		defer cancel()
@@ -44,7 +48,23 @@ func TestGetPeerInfo(t *testing.T) {
 			return
 		}
 
-		assertar.Equal(peer, WireToPeer(got))
+		assertar.Equal(peer.AsPeer(), WireToPeer(got))
+		assertar.NoError(verifyRecord(got))
+	})
+
+	t.Run("not self-signed peer", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		peer := FakePeer("unreachable")
+		store.SetPeer(peer)
+
+		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+		defer cancel()
+
+		_, err := client.GetPeerInfo(ctx, &api.PeerRequest{
+			PeerID: peer.ID.Hex(),
+		})
+		assertar.Equal(codes.NotFound, status.Code(err))
 	})
 
 	t.Run("no existing peer", func(t *testing.T) {
@@ -75,3 +95,87 @@ func TestGetPeerInfo(t *testing.T) {
 	})
 
 }
+
+func TestStreamEvents(t *testing.T) {
+	store := NewMemStore()
+	n := NewForTests("server.fake", store, nil)
+	n.StartService()
+	defer n.Stop()
+
+	c := NewForTests("client.fake", nil, nil)
+	c.initClient()
+	defer c.Stop()
+
+	client, free, fail, err := c.ConnectTo(n.AsPeer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer free()
+
+	nodes, events := inter.GenEventsByNode(3, 5, 2)
+	req := &api.EventsRequest{
+		Heights: make(map[string]*api.Interval, len(nodes)),
+	}
+	for _, creator := range nodes {
+		for _, e := range events[creator] {
+			store.SetEvent(e)
+			store.SetEventHash(e.Creator, e.Index, e.Hash())
+		}
+		req.Heights[creator.Hex()] = &api.Interval{From: 2, To: 5}
+	}
+
+	stream := func(req *api.EventsRequest) (res []*wire.Event) {
+		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+		defer cancel()
+
+		s, err := client.StreamEvents(ctx, req)
+		if !assert.NoError(t, err) {
+			fail(err)
+			return
+		}
+		for {
+			w, err := s.Recv()
+			if err == io.EOF {
+				return
+			}
+			if !assert.NoError(t, err) {
+				fail(err)
+				return
+			}
+			res = append(res, w)
+		}
+	}
+
+	t.Run("parent-first order", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		got := stream(req)
+		if !assertar.Len(got, 3*4) {
+			return
+		}
+
+		known := hash.Events{}
+		for _, creator := range nodes {
+			known.Add(events[creator][0].Hash())
+		}
+		for _, w := range got {
+			e := inter.WireToEvent(w)
+			for p := range e.Parents {
+				assertar.True(known.Contains(p), "parent is sent first")
+			}
+			known.Add(e.Hash())
+		}
+	})
+
+	t.Run("byte budget", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		req.MaxBytes = 1
+		defer func() {
+			req.MaxBytes = 0
+		}()
+
+		got := stream(req)
+		assertar.Len(got, 1, "at least one event is sent")
+	})
+}
@@ -16,16 +16,16 @@ type Store struct {
 		Peers       kvdb.Database `table:"peer_"`
 		PeersTop    kvdb.Database `table:"top_peers_"`
 		PeerHeights kvdb.Database `table:"peer_height_"`
+		PeerBans    kvdb.Database `table:"ban_"`
+		PeerAddrs   kvdb.Database `table:"addr_"`
 
-		Events kvdb.Database `table:"event_"`
-		Hashes kvdb.Database `table:"hash_"`
+		Events  kvdb.Database `table:"event_"`
+		Hashes  kvdb.Database `table:"hash_"`
+		Headers kvdb.Database `table:"header_"`
 
 		Txn2Event kvdb.Database `table:"txn2event_"`
-		/*
-			Nonce       kvdb.Database `table:"nonce_"`
-			NonceTxs    kvdb.Database `table:"nonce_tx_"`
-			NonceEvents kvdb.Database `table:"nonce_event_"`
-		*/
+
+		ForkProofs kvdb.Database `table:"fork_proof_"`
 	}
 
 	logger.Instance
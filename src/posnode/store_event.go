@@ -17,6 +17,36 @@ func (s *Store) GetEvent(h hash.Event) *inter.Event {
 	return inter.WireToEvent(w)
 }
 
+// DeleteEvent removes stored event.
+// The last event of creator is kept to continue its sequence.
+// Hash index and header of event are kept to detect forks (see GetEventHeader),
+// txn indexes are kept to not accept the included txns again (see HasTxnsEvent).
+func (s *Store) DeleteEvent(h hash.Event) {
+	e := s.GetEvent(h)
+	if e == nil {
+		return
+	}
+	if s.GetPeerHeight(e.Creator) <= e.Index {
+		return
+	}
+
+	s.set(s.table.Headers, h.Bytes(), e.Header().ToWire())
+
+	if err := s.table.Events.Delete(h.Bytes()); err != nil {
+		s.Fatal(err)
+	}
+}
+
+// GetEventHeader returns header of stored or deleted event.
+func (s *Store) GetEventHeader(h hash.Event) *inter.EventHeader {
+	if e := s.GetEvent(h); e != nil {
+		return e.Header()
+	}
+
+	w, _ := s.get(s.table.Headers, h.Bytes(), &wire.EventHeader{}).(*wire.EventHeader)
+	return inter.WireToEventHeader(w)
+}
+
 // HasEvent returns true if event exists.
 func (s *Store) HasEvent(h hash.Event) bool {
 	return s.has(s.table.Events, h.Bytes())
@@ -0,0 +1,24 @@
+package posnode
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// SetForkProof stores proof of cheater's fork.
+func (s *Store) SetForkProof(p *inter.ForkProof) {
+	cheater := p.Cheater()
+	s.set(s.table.ForkProofs, cheater.Bytes(), p.ToWire())
+}
+
+// GetForkProof returns stored proof of cheater's fork.
+func (s *Store) GetForkProof(cheater hash.Peer) *inter.ForkProof {
+	w, _ := s.get(s.table.ForkProofs, cheater.Bytes(), &wire.ForkProof{}).(*wire.ForkProof)
+	return inter.WireToForkProof(w)
+}
+
+// HasForkProof returns true if cheater's fork is known.
+func (s *Store) HasForkProof(cheater hash.Peer) bool {
+	return s.has(s.table.ForkProofs, cheater.Bytes())
+}
@@ -80,6 +80,28 @@ func (s *Store) GetTopPeers() []hash.Peer {
 	return WireToIDs(w)
 }
 
+// SetPeerBan stores peer ban.
+func (s *Store) SetPeerBan(id hash.Peer, ban *api.PeerBan) {
+	s.set(s.table.PeerBans, id.Bytes(), ban)
+}
+
+// GetPeerBan returns stored peer ban.
+func (s *Store) GetPeerBan(id hash.Peer) *api.PeerBan {
+	w, _ := s.get(s.table.PeerBans, id.Bytes(), &api.PeerBan{}).(*api.PeerBan)
+	return w
+}
+
+// SetPeerAddrs stores address book of peer.
+func (s *Store) SetPeerAddrs(id hash.Peer, addrs *api.PeerAddrs) {
+	s.set(s.table.PeerAddrs, id.Bytes(), addrs)
+}
+
+// GetPeerAddrs returns stored address book of peer.
+func (s *Store) GetPeerAddrs(id hash.Peer) *api.PeerAddrs {
+	w, _ := s.get(s.table.PeerAddrs, id.Bytes(), &api.PeerAddrs{}).(*api.PeerAddrs)
+	return w
+}
+
 // SetPeerHeight stores last event index of peer.
 func (s *Store) SetPeerHeight(id hash.Peer, height uint64) {
 	if err := s.table.PeerHeights.Put(id.Bytes(), intToBytes(height)); err != nil {
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
 
 func Test_IntToBytes(t *testing.T) {
@@ -20,3 +23,45 @@ func Test_IntToBytes(t *testing.T) {
 		assertar.Equal(n1, n2)
 	}
 }
+
+func TestStoreDeleteEvent(t *testing.T) {
+	assertar := assert.New(t)
+
+	store := NewMemStore()
+	defer store.Close()
+
+	creator := hash.FakePeer()
+	events := make([]*inter.Event, 2)
+	for i := range events {
+		events[i] = &inter.Event{
+			Index:   uint64(i + 1),
+			Creator: creator,
+			Parents: hash.Events{},
+			InternalTransactions: []*inter.InternalTransaction{
+				{Index: uint64(i), Amount: 1},
+			},
+		}
+		h := events[i].Hash()
+		store.SetEvent(events[i])
+		store.SetEventHash(creator, events[i].Index, h)
+		store.SetTxnsEvent(h, creator, events[i].InternalTransactions...)
+		store.SetPeerHeight(creator, events[i].Index)
+	}
+
+	for _, e := range events {
+		store.DeleteEvent(e.Hash())
+	}
+
+	first, last := events[0], events[1]
+	assertar.Nil(store.GetEvent(first.Hash()))
+	assertar.Equal(first.Hash(), *store.GetEventHash(creator, first.Index), "kept for forks detection")
+	if header := store.GetEventHeader(first.Hash()); assertar.NotNil(header) {
+		assertar.Equal(first.Hash(), header.Hash())
+	}
+	assertar.Nil(store.GetTxnsEvent(inter.TransactionHashOf(creator, 0)))
+	assertar.True(store.HasTxnsEvent(inter.TransactionHashOf(creator, 0)), "kept to not accept txn again")
+
+	assertar.NotNil(store.GetEvent(last.Hash()), "last event of creator is kept")
+	assertar.NotNil(store.GetEventHash(creator, last.Index))
+	assertar.NotNil(store.GetTxnsEvent(inter.TransactionHashOf(creator, 1)))
+}
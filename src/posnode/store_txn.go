@@ -7,22 +7,26 @@ import (
 
 // SetTxnsEvent stores txn-to-event index.
 func (s *Store) SetTxnsEvent(e hash.Event, sender hash.Peer, txns ...*inter.InternalTransaction) {
-	batch := s.table.Txn2Event.NewBatch()
-	defer batch.Reset()
-
-	for _, txn := range txns {
-		idx := inter.TransactionHashOf(sender, txn.Index)
-		if err := batch.Put(idx.Bytes(), e.Bytes()); err != nil {
-			s.Fatal(err)
-		}
+	idxs := make([]hash.Transaction, len(txns))
+	for i, txn := range txns {
+		idxs[i] = inter.TransactionHashOf(sender, txn.Index)
 	}
 
-	if err := batch.Write(); err != nil {
-		s.Fatal(err)
+	s.setTxnsEvent(e, idxs)
+}
+
+// SetExternalTxnsEvent stores external txn-to-event index.
+func (s *Store) SetExternalTxnsEvent(e hash.Event, txns ...[]byte) {
+	idxs := make([]hash.Transaction, len(txns))
+	for i, txn := range txns {
+		idxs[i] = inter.ExternalTransactionHashOf(txn)
 	}
+
+	s.setTxnsEvent(e, idxs)
 }
 
 // GetTxnsEvent returns event includes the specified txn.
+// It returns nil if the event is deleted already.
 func (s *Store) GetTxnsEvent(idx hash.Transaction) *inter.Event {
 	buf, err := s.table.Txn2Event.Get(idx.Bytes())
 	if err != nil {
@@ -36,3 +40,28 @@ func (s *Store) GetTxnsEvent(idx hash.Transaction) *inter.Event {
 
 	return s.GetEvent(e)
 }
+
+// HasTxnsEvent returns true if the specified txn is included in any event,
+// even in deleted one.
+func (s *Store) HasTxnsEvent(idx hash.Transaction) bool {
+	return s.has(s.table.Txn2Event, idx.Bytes())
+}
+
+/*
+ * Utils:
+ */
+
+func (s *Store) setTxnsEvent(e hash.Event, idxs []hash.Transaction) {
+	batch := s.table.Txn2Event.NewBatch()
+	defer batch.Reset()
+
+	for _, idx := range idxs {
+		if err := batch.Put(idx.Bytes(), e.Bytes()); err != nil {
+			s.Fatal(err)
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		s.Fatal(err)
+	}
+}
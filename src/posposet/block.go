@@ -1,10 +1,22 @@
 package posposet
 
 import (
+	"fmt"
+
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
 
+// GetBlock returns block by number.
+func (p *Poset) GetBlock(n uint64) *inter.Block {
+	return p.store.GetBlock(n)
+}
+
+// LastBlockN returns number of the last block.
+func (p *Poset) LastBlockN() uint64 {
+	return p.state.LastBlockN
+}
+
 // GetEventBlock returns block includes event.
 func (p *Poset) GetEventBlock(e hash.Event) *inter.Block {
 	num := p.store.GetEventBlockNum(e)
@@ -14,3 +26,32 @@ func (p *Poset) GetEventBlock(e hash.Event) *inter.Block {
 
 	return p.store.GetBlock(*num)
 }
+
+// GetReceipt returns result of ordered internal transaction.
+func (p *Poset) GetReceipt(idx hash.Transaction) *inter.Receipt {
+	return p.store.GetReceipt(idx)
+}
+
+// makeBlock makes next chain block from ordered events of frame.
+// It returns error if the previous block is not found.
+func (p *Poset) makeBlock(frameN uint64, ordered inter.Events) (*inter.Block, error) {
+	frame := p.frame(frameN, false)
+
+	var time inter.Timestamp
+	for _, t := range frame.Atroposes {
+		if time < t {
+			time = t
+		}
+	}
+
+	var parent hash.Hash
+	if p.state.LastBlockN > 0 {
+		prev := p.store.GetBlock(p.state.LastBlockN)
+		if prev == nil {
+			return nil, fmt.Errorf("previous block %d not found", p.state.LastBlockN)
+		}
+		parent = prev.Hash()
+	}
+
+	return inter.NewBlock(p.state.LastBlockN+1, frameN, time, parent, frame.Balances, ordered), nil
+}
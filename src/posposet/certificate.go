@@ -0,0 +1,115 @@
+package posposet
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+// maxBlockSignsAhead is a max distance from the last block to the signed one.
+// Signs of further blocks are ignored.
+const maxBlockSignsAhead = 32
+
+// GetCertificate returns finality certificate of block
+// or nil if validators with more than 2/3 of stake have not signed the block yet.
+// NOTE: validators of the block's epoch are used.
+func (p *Poset) GetCertificate(n uint64) *inter.BlockCertificate {
+	cert := p.store.GetCertificate(n)
+	if cert == nil {
+		return nil
+	}
+
+	cert.Block = p.store.GetBlock(n)
+	if err := cert.Verify(p.store.GetEpochValidators(cert.Epoch)); err != nil {
+		return nil
+	}
+	return cert
+}
+
+// takeBlockSigns collects validators signatures of blocks gossiped with event.
+// Block may be unknown yet, so signatures are collected before the block is.
+// Doubled signatures are ignored, so it is safe to take event again (see reconsensusFromFrame).
+// It is not safe for concurrent use.
+func (p *Poset) takeBlockSigns(e *inter.Event) {
+	for _, s := range e.BlockSigns {
+		if err := s.Verify(); err != nil {
+			p.Warnf("block sign from %s is invalid: %s, skipped", e.Creator.String(), err)
+			continue
+		}
+		if signer := s.Signer(); signer != e.Creator {
+			p.Warnf("block sign of %s is gossiped by %s, skipped", signer.String(), e.Creator.String())
+			continue
+		}
+		p.addBlockSign(s)
+	}
+}
+
+// addBlockSign adds signature to block certificate if it is not complete yet.
+// Signer should be a validator of the block's epoch (of the current epoch if block
+// is not made yet) and block should be not far ahead of the last one.
+// Only one sign of the signer is kept for the block, conflicting ones are skipped
+// (the kept one is dropped later if it is not valid for the block, see bindCertificate).
+func (p *Poset) addBlockSign(s *inter.BlockSign) {
+	if s.BlockN > p.state.LastBlockN+maxBlockSignsAhead {
+		p.Warnf("block %d is too far ahead of the last block %d, sign skipped", s.BlockN, p.state.LastBlockN)
+		return
+	}
+
+	cert := p.store.GetCertificate(s.BlockN)
+	if cert == nil {
+		cert = &inter.BlockCertificate{
+			Epoch: p.state.Epoch,
+		}
+	}
+
+	validators := p.store.GetEpochValidators(cert.Epoch)
+	signer := s.Signer()
+	if _, ok := validators[signer]; !ok {
+		p.Warnf("block %d signer %s is not a validator of epoch %d, sign skipped", s.BlockN, signer.String(), cert.Epoch)
+		return
+	}
+
+	cert.Block = p.store.GetBlock(s.BlockN)
+	if cert.Block != nil {
+		if s.BlockHash != cert.Block.Hash() {
+			p.Warnf("block %d sign of %s has wrong hash, skipped", s.BlockN, signer.String())
+			return
+		}
+		if cert.Verify(validators) == nil {
+			return
+		}
+	}
+
+	for _, prev := range cert.Signs {
+		if prev.Signer() != signer {
+			continue
+		}
+		if prev.BlockHash != s.BlockHash {
+			p.Warnf("block %d sign of %s conflicts with the previous one, skipped", s.BlockN, signer.String())
+		}
+		return
+	}
+
+	cert.Signs = append(cert.Signs, s)
+	p.store.SetCertificate(s.BlockN, cert)
+}
+
+// bindCertificate sets epoch of new block certificate
+// and drops signs collected before the block is made if they are not valid for it.
+func (p *Poset) bindCertificate(block *inter.Block) {
+	cert := p.store.GetCertificate(block.Index)
+	if cert == nil {
+		cert = &inter.BlockCertificate{}
+	}
+	cert.Epoch = p.state.Epoch
+
+	validators := p.state.Validators
+	h := block.Hash()
+	signs := cert.Signs[:0]
+	for _, s := range cert.Signs {
+		if _, ok := validators[s.Signer()]; ok && s.BlockHash == h {
+			signs = append(signs, s)
+		}
+	}
+	cert.Signs = signs
+
+	p.store.SetCertificate(block.Index, cert)
+}
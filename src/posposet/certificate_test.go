@@ -0,0 +1,103 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+func TestPosetCertificate(t *testing.T) {
+	assertar := assert.New(t)
+
+	keys := make([]*common.PrivateKey, 3)
+	nodes := make([]hash.Peer, len(keys))
+	for i := range keys {
+		keys[i] = crypto.GenerateKey()
+		nodes[i] = hash.PeerOfPubkey(keys[i].Public())
+	}
+
+	p, s, _ := FakePoset(nodes)
+
+	block := inter.NewBlock(1, 1, 0, hash.Hash{}, p.state.Genesis, nil)
+	signOf := func(key *common.PrivateKey, b *inter.Block) *inter.BlockSign {
+		s, err := inter.NewBlockSign(key, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	sign := func(i int) *inter.BlockSign {
+		return signOf(keys[i], block)
+	}
+	event := func(creator hash.Peer, signs ...*inter.BlockSign) *inter.Event {
+		return &inter.Event{
+			Index:      1,
+			Creator:    creator,
+			Parents:    hash.NewEvents(hash.ZeroEvent),
+			BlockSigns: signs,
+		}
+	}
+	signsCount := func() int {
+		cert := s.GetCertificate(1)
+		if cert == nil {
+			return 0
+		}
+		return len(cert.Signs)
+	}
+
+	// signs before block is known
+	p.takeBlockSigns(event(nodes[0], sign(0)))
+	// sign of another validator
+	p.takeBlockSigns(event(nodes[0], sign(1)))
+	assertar.Nil(p.GetCertificate(1))
+	assertar.Equal(1, signsCount())
+
+	// sign of not a validator
+	outsider := crypto.GenerateKey()
+	p.takeBlockSigns(event(hash.PeerOfPubkey(outsider.Public()), signOf(outsider, block)))
+	assertar.Equal(1, signsCount())
+
+	// sign of too far block
+	far := inter.NewBlock(maxBlockSignsAhead+1, 1, 0, hash.Hash{}, p.state.Genesis, nil)
+	p.takeBlockSigns(event(nodes[0], signOf(keys[0], far)))
+	assertar.Nil(s.GetCertificate(far.Index))
+
+	// early sign of wrong block doesn't prevent the right one
+	wrong := inter.NewBlock(1, 2, 0, hash.Hash{}, p.state.Genesis, nil)
+	p.takeBlockSigns(event(nodes[1], signOf(keys[1], wrong)))
+	assertar.Equal(2, signsCount())
+
+	// conflicting signs of the same block are skipped
+	wrong2 := inter.NewBlock(1, 3, 0, hash.Hash{}, p.state.Genesis, nil)
+	p.takeBlockSigns(event(nodes[0], signOf(keys[0], wrong)))
+	p.takeBlockSigns(event(nodes[1], signOf(keys[1], wrong2)))
+	assertar.Equal(2, signsCount())
+
+	s.SetBlock(block)
+	p.bindCertificate(block)
+	assertar.Equal(1, signsCount(), "wrong sign is dropped")
+
+	p.takeBlockSigns(event(nodes[1], sign(1)))
+	assertar.Nil(p.GetCertificate(1), "2/3 is not more than 2/3")
+
+	p.takeBlockSigns(event(nodes[2], sign(2), sign(2)))
+	cert := p.GetCertificate(1)
+	if !assertar.NotNil(cert) {
+		return
+	}
+	assertar.Equal(block.Hash(), cert.Block.Hash())
+	assertar.Len(cert.Signs, 3)
+	assertar.NoError(cert.Verify(p.state.Validators))
+
+	// certificate is verifiable after epoch change
+	p.state.Epoch = 1
+	p.state.Validators = Validators{
+		hash.PeerOfPubkey(outsider.Public()): 1,
+	}
+	assertar.NotNil(p.GetCertificate(1))
+}
@@ -0,0 +1,204 @@
+package posposet
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
+)
+
+type (
+	// Checkpoint is a certified block to fast sync from
+	// with the chain of epochs validators since genesis to verify it.
+	Checkpoint struct {
+		Cert   *inter.BlockCertificate
+		Epochs []*EpochProof // of epochs 1..Cert.Epoch
+	}
+
+	// EpochProof is a validators set of epoch with certificate
+	// of the block which has committed it (see Block.NextValidators).
+	EpochProof struct {
+		Validators Validators
+		Cert       *inter.BlockCertificate
+	}
+
+	// checkpointApply is a request to apply checkpoint by events processing.
+	checkpointApply struct {
+		checkpoint *Checkpoint
+		result     chan error
+	}
+)
+
+/*
+ * Poset's methods:
+ */
+
+// GetCheckpoint returns the last certified block of the current epoch
+// with proofs of epochs validators. It is a point for fast sync of other nodes.
+func (p *Poset) GetCheckpoint() *Checkpoint {
+	lowest := p.state.EpochStartBlockN
+	if lowest < p.state.LowestBlockN {
+		lowest = p.state.LowestBlockN
+	}
+
+	var cert *inter.BlockCertificate
+	for n := p.state.LastBlockN; n >= lowest && n > 0 && cert == nil; n-- {
+		cert = p.GetCertificate(n)
+	}
+	if cert == nil {
+		return nil
+	}
+
+	epochs := make([]*EpochProof, 0, p.state.Epoch)
+	for epoch := uint64(1); epoch <= p.state.Epoch; epoch++ {
+		proof := p.GetCertificate(p.store.GetEpochBlockN(epoch))
+		if proof == nil {
+			p.Warnf("block which has committed epoch %d is not certified", epoch)
+			return nil
+		}
+		epochs = append(epochs, &EpochProof{
+			Validators: p.store.GetEpochValidators(epoch),
+			Cert:       proof,
+		})
+	}
+
+	return &Checkpoint{
+		Cert:   cert,
+		Epochs: epochs,
+	}
+}
+
+// GetStateNode returns node of balances trie.
+func (p *Poset) GetStateNode(h hash.Hash) []byte {
+	return p.store.GetStateNode(h)
+}
+
+// StateSync makes scheduler to download balances trie of checkpoint.
+// Checkpoint is verified first (see verifyCheckpoint).
+func (p *Poset) StateSync(c *Checkpoint) (*trie.Sync, error) {
+	if _, err := p.verifyCheckpoint(c); err != nil {
+		return nil, err
+	}
+
+	return p.store.StateSync(c.Cert.Block.StateRoot), nil
+}
+
+// CommitStateSync writes downloaded nodes of balances trie.
+func (p *Poset) CommitStateSync(sched *trie.Sync) error {
+	return p.store.CommitStateSync(sched)
+}
+
+// ApplyCheckpoint starts consensus from the frame of checkpoint block.
+// Balances trie of the block should be downloaded already.
+// NOTE: frames before the checkpoint are unknown, so events which refer to them are too old.
+// If events processing is started, checkpoint is applied by it between events.
+func (p *Poset) ApplyCheckpoint(c *Checkpoint) error {
+	done := p.processingDone
+	if done == nil {
+		return p.applyCheckpoint(c)
+	}
+
+	req := &checkpointApply{
+		checkpoint: c,
+		result:     make(chan error, 1),
+	}
+	select {
+	case p.checkpointCh <- req:
+		return <-req.result
+	case <-done:
+		return fmt.Errorf("events processing is stopped")
+	}
+}
+
+// applyCheckpoint is not safe for concurrent use.
+func (p *Poset) applyCheckpoint(c *Checkpoint) error {
+	validators, err := p.verifyCheckpoint(c)
+	if err != nil {
+		return err
+	}
+	block := c.Cert.Block
+	if inter.EventsRootOf(block.Events) != block.EventsRoot {
+		return fmt.Errorf("events of block %d do not match the events root", block.Index)
+	}
+	if block.Index <= p.state.LastBlockN {
+		return fmt.Errorf("block %d is not ahead of the last block %d", block.Index, p.state.LastBlockN)
+	}
+	if _, err := state.New(block.StateRoot, p.store.table.Balances); err != nil {
+		return err
+	}
+
+	// keep epochs proofs to serve checkpoints too
+	for i, proof := range c.Epochs {
+		p.store.SetBlock(proof.Cert.Block)
+		p.store.SetCertificate(proof.Cert.Block.Index, proof.Cert)
+		p.store.SetEpochValidators(uint64(i+1), proof.Validators, proof.Cert.Block.Index)
+	}
+
+	p.store.SetBlock(block)
+	p.store.SetCertificate(block.Index, c.Cert)
+	p.store.SetFrame(&Frame{
+		Index:            block.Frame,
+		FlagTable:        FlagTable{},
+		ClothoCandidates: EventsByPeer{},
+		Atroposes:        TimestampsByEvent{},
+		Balances:         block.StateRoot,
+	})
+
+	p.state.LastFinishedFrameN = block.Frame
+	p.state.LastBlockN = block.Index
+	p.state.LowestBlockN = block.Index
+	p.state.Epoch = c.Cert.Epoch
+	p.state.EpochStartBlockN = p.store.GetEpochBlockN(c.Cert.Epoch) + 1
+	p.state.Validators = validators
+	p.state.TotalCap = p.state.Validators.Total()
+	p.saveState()
+
+	p.frames = make(map[uint64]*Frame)
+	p.frames[block.Frame] = p.store.GetFrame(block.Frame)
+
+	p.Infof("checkpoint: consensus starts from block %d, frame %d", block.Index, block.Frame)
+	return nil
+}
+
+// verifyCheckpoint checks the chain of epochs validators from the genesis ones:
+// each set should be committed by block certified by the previous set.
+// It returns validators of checkpoint epoch which have certified the checkpoint.
+func (p *Poset) verifyCheckpoint(c *Checkpoint) (Validators, error) {
+	if c == nil || c.Cert == nil || c.Cert.Block == nil {
+		return nil, fmt.Errorf("no checkpoint block")
+	}
+	if c.Cert.Epoch != uint64(len(c.Epochs)) {
+		return nil, fmt.Errorf("checkpoint of epoch %d has %d epochs proofs", c.Cert.Epoch, len(c.Epochs))
+	}
+
+	validators := p.store.GetEpochValidators(0)
+	var lastBlockN uint64
+	for i, proof := range c.Epochs {
+		epoch := uint64(i + 1)
+		if proof.Cert == nil || proof.Cert.Block == nil {
+			return nil, fmt.Errorf("no block which has committed epoch %d", epoch)
+		}
+		if proof.Cert.Epoch != epoch-1 || proof.Cert.Block.Index <= lastBlockN {
+			return nil, fmt.Errorf("block %d is out of epoch %d", proof.Cert.Block.Index, epoch-1)
+		}
+		if err := proof.Cert.Verify(validators); err != nil {
+			return nil, err
+		}
+		if proof.Cert.Block.NextValidators != proof.Validators.Hash() {
+			return nil, fmt.Errorf("validators of epoch %d are not committed by block %d", epoch, proof.Cert.Block.Index)
+		}
+		validators = proof.Validators
+		lastBlockN = proof.Cert.Block.Index
+	}
+
+	if c.Cert.Block.Index <= lastBlockN {
+		return nil, fmt.Errorf("block %d is out of epoch %d", c.Cert.Block.Index, c.Cert.Epoch)
+	}
+	if err := c.Cert.Verify(validators); err != nil {
+		return nil, err
+	}
+
+	return validators, nil
+}
@@ -0,0 +1,167 @@
+package posposet
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
+)
+
+func TestPosetCheckpoint(t *testing.T) {
+	assertar := assert.New(t)
+
+	keys := make([]*common.PrivateKey, 3)
+	nodes := make([]hash.Peer, len(keys))
+	for i := range keys {
+		keys[i] = crypto.GenerateKey()
+		nodes[i] = hash.PeerOfPubkey(keys[i].Public())
+	}
+
+	// source poset has certified block
+	src, _, _ := FakePoset(nodes)
+	receiver := hash.FakePeer()
+	db := src.store.StateDB(src.state.Genesis)
+	db.SetBalance(receiver, 5)
+	root, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+	certify := func(block *inter.Block, keys ...*common.PrivateKey) bool {
+		src.store.SetBlock(block)
+		src.bindCertificate(block)
+		src.state.LastBlockN = block.Index
+		for _, key := range keys {
+			sign, err := inter.NewBlockSign(key, block)
+			if !assertar.NoError(err) {
+				return false
+			}
+			src.addBlockSign(sign)
+		}
+		return assertar.NotNil(src.GetCertificate(block.Index))
+	}
+
+	// the first block of epoch 0 commits validators of epoch 1
+	next := Validators{
+		nodes[0]: 1,
+		nodes[1]: 1,
+	}
+	last := inter.NewBlock(3, 4, 0, hash.FakeHash(), src.state.Genesis, nil)
+	last.NextValidators = next.Hash()
+	if !certify(last, keys...) {
+		return
+	}
+	src.store.SetEpochValidators(1, next, last.Index)
+	src.nextEpoch(1)
+
+	block := inter.NewBlock(5, 7, 0, hash.FakeHash(), root, nil)
+	if !certify(block, keys[:2]...) {
+		return
+	}
+
+	checkpoint := src.GetCheckpoint()
+	if !assertar.NotNil(checkpoint) {
+		return
+	}
+	assertar.Equal(block.Hash(), checkpoint.Cert.Block.Hash())
+	assertar.Equal(uint64(1), checkpoint.Cert.Epoch)
+	assertar.Equal(1, len(checkpoint.Epochs))
+
+	// fresh poset
+	dst, _, input := FakePoset(nodes)
+
+	t.Run("balances are not downloaded", func(t *testing.T) {
+		assertar := assert.New(t)
+		assertar.Error(dst.ApplyCheckpoint(checkpoint))
+	})
+
+	t.Run("download balances", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		sched, err := dst.StateSync(checkpoint)
+		if !assertar.NoError(err) {
+			return
+		}
+		for queue := sched.Missing(10); len(queue) > 0; queue = sched.Missing(10) {
+			results := make([]trie.SyncResult, len(queue))
+			for i, h := range queue {
+				results[i] = trie.SyncResult{Hash: h, Data: src.GetStateNode(h)}
+			}
+			if _, _, err := sched.Process(results); !assertar.NoError(err) {
+				return
+			}
+			if !assertar.NoError(dst.CommitStateSync(sched)) {
+				return
+			}
+		}
+	})
+
+	t.Run("wrong validators", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		forged := &Checkpoint{
+			Cert: checkpoint.Cert,
+			Epochs: []*EpochProof{{
+				Validators: Validators{receiver: 5},
+				Cert:       checkpoint.Epochs[0].Cert,
+			}},
+		}
+		assertar.Error(dst.ApplyCheckpoint(forged))
+
+		unchained := &Checkpoint{
+			Cert: checkpoint.Cert,
+		}
+		assertar.Error(dst.ApplyCheckpoint(unchained))
+	})
+
+	t.Run("apply checkpoint", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		// with events processing in progress
+		dst.Start()
+		_, events := inter.GenEventsByNode(3, 50, 2)
+		var wg sync.WaitGroup
+		for _, ee := range events {
+			wg.Add(1)
+			go func(ee []*inter.Event) {
+				defer wg.Done()
+				for _, e := range ee {
+					input.SetEvent(e)
+					dst.PushEvent(e.Hash())
+				}
+			}(ee)
+		}
+		err := dst.ApplyCheckpoint(checkpoint)
+		wg.Wait()
+		dst.Stop()
+		if !assertar.NoError(err) {
+			return
+		}
+		assertar.Equal(block.Index, dst.LastBlockN())
+		assertar.Equal(block.Index, dst.LowestBlockN())
+		assertar.Equal(block.Frame, dst.state.LastFinishedFrameN)
+		assertar.Equal(block.Hash(), dst.GetBlock(block.Index).Hash())
+		assertar.NotNil(dst.GetCertificate(block.Index))
+		assertar.Equal(uint64(1), dst.StakeOf(nodes[0]))
+		assertar.Equal(next, dst.state.Validators)
+		assertar.Equal(uint64(1), dst.state.Epoch)
+		assertar.Equal(last.Index+1, dst.state.EpochStartBlockN)
+		// and can serve the checkpoint too
+		served := dst.GetCheckpoint()
+		if assertar.NotNil(served) {
+			assertar.Equal(checkpoint.Cert.Block.Hash(), served.Cert.Block.Hash())
+			assertar.Equal(checkpoint.Epochs[0].Validators, served.Epochs[0].Validators)
+		}
+		assertar.Equal(uint64(5), dst.store.StateDB(root).FreeBalance(receiver))
+	})
+
+	t.Run("not ahead checkpoint", func(t *testing.T) {
+		assertar := assert.New(t)
+		assertar.Error(dst.ApplyCheckpoint(checkpoint))
+	})
+}
@@ -10,13 +10,31 @@ import (
 // FakePoset creates empty poset with mem store and equal stakes of nodes in genesis.
 // Input event order doesn't matter.
 func FakePoset(nodes []hash.Peer) (*Poset, *Store, *EventStore) {
+	return FakeEconomyPoset(nodes, 1, Economy{})
+}
+
+// FakeEconomyPoset creates empty poset with mem store, equal stakes of nodes in genesis
+// and fees and rewards schedule.
+// Input event order doesn't matter.
+func FakeEconomyPoset(nodes []hash.Peer, stake uint64, economy Economy) (*Poset, *Store, *EventStore) {
+	return fakePoset(nodes, stake, economy, DefaultRules())
+}
+
+// FakeRulesPoset creates empty poset with mem store, equal stakes of nodes in genesis
+// and custom consensus rules.
+// Input event order doesn't matter.
+func FakeRulesPoset(nodes []hash.Peer, rules ConsensusRules) (*Poset, *Store, *EventStore) {
+	return fakePoset(nodes, 1, Economy{}, rules)
+}
+
+func fakePoset(nodes []hash.Peer, stake uint64, economy Economy, rules ConsensusRules) (*Poset, *Store, *EventStore) {
 	balances := make(map[hash.Peer]uint64, len(nodes))
 	for _, addr := range nodes {
-		balances[addr] = uint64(1)
+		balances[addr] = stake
 	}
 
 	store := NewMemStore()
-	err := store.ApplyGenesis(balances)
+	err := store.ApplyGenesis(balances, economy, rules)
 	if err != nil {
 		panic(err)
 	}
@@ -33,6 +51,8 @@ func FakePoset(nodes []hash.Peer) (*Poset, *Store, *EventStore) {
 // MakeOrderedInput wraps Poset.onNewEvent with ordering.EventBuffer.
 func MakeOrderedInput(p *Poset) {
 	orderThenConsensus := ordering.EventBuffer(
+		// limits
+		func() ordering.Limits { return ordering.Limits{} },
 		// process
 		p.consensus,
 		// drop
@@ -46,6 +66,8 @@ func MakeOrderedInput(p *Poset) {
 			}
 			return p.input.GetEvent(h)
 		},
+		// fork
+		nil,
 	)
 	// event order doesn't matter now
 	p.onNewEvent = func(e *inter.Event) {
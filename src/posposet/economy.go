@@ -0,0 +1,102 @@
+package posposet
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
+)
+
+// Economy is a schedule of transaction fees and block rewards.
+type Economy struct {
+	InternalTxnFee     uint64 // fee for each internal transaction of event
+	ExternalTxnByteFee uint64 // fee for each byte of event's external transactions
+	BlockReward        uint64 // new stake issued with each block
+}
+
+// EventFee returns fee the event creator should pay.
+// It is MaxUint64 on overflow, so the fee is never paid.
+func (e *Economy) EventFee(event *inter.Event) uint64 {
+	fee := mulSat(e.InternalTxnFee, uint64(len(event.InternalTransactions)))
+	for _, tx := range event.ExternalTransactions {
+		fee = addSat(fee, e.ExternalTxnFee(tx))
+	}
+	return fee
+}
+
+// ExternalTxnFee returns fee the event creator should pay for the external transaction.
+func (e *Economy) ExternalTxnFee(tx []byte) uint64 {
+	return mulSat(e.ExternalTxnByteFee, uint64(len(tx)))
+}
+
+// Hash returns hash of economy to compare it with other nodes.
+func (e *Economy) Hash() hash.Hash {
+	var pbf proto.Buffer
+	pbf.SetDeterministic(true)
+	if err := pbf.Marshal(e.ToWire()); err != nil {
+		panic(err)
+	}
+	return hash.Of(pbf.Bytes())
+}
+
+// ToWire converts to proto.Message.
+func (e *Economy) ToWire() *wire.Economy {
+	return &wire.Economy{
+		InternalTxnFee:     e.InternalTxnFee,
+		ExternalTxnByteFee: e.ExternalTxnByteFee,
+		BlockReward:        e.BlockReward,
+	}
+}
+
+// WireToEconomy converts from wire.
+func WireToEconomy(w *wire.Economy) Economy {
+	if w == nil {
+		return Economy{}
+	}
+	return Economy{
+		InternalTxnFee:     w.InternalTxnFee,
+		ExternalTxnByteFee: w.ExternalTxnByteFee,
+		BlockReward:        w.BlockReward,
+	}
+}
+
+/*
+ * Poset's methods:
+ */
+
+// GetEconomy returns schedule of transaction fees and block rewards.
+func (p *Poset) GetEconomy() Economy {
+	return p.state.Economy
+}
+
+/*
+ * Utils:
+ */
+
+// mulSat returns a*b or MaxUint64 on overflow.
+func mulSat(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+	return a * b
+}
+
+// addSat returns a+b or MaxUint64 on overflow.
+func addSat(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
+
+// mulDiv returns a*b/c without overflow.
+func mulDiv(a, b, c uint64) uint64 {
+	x := new(big.Int).SetUint64(a)
+	x.Mul(x, new(big.Int).SetUint64(b))
+	x.Div(x, new(big.Int).SetUint64(c))
+	return x.Uint64()
+}
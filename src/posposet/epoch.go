@@ -0,0 +1,146 @@
+package posposet
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+)
+
+// Validators is a stakes of epoch participants.
+type Validators map[hash.Peer]uint64
+
+// Total returns sum of validators stakes.
+func (vv Validators) Total() (total uint64) {
+	for _, stake := range vv {
+		total += stake
+	}
+	return
+}
+
+// Hash calcs hash of validators set, ordered by address.
+// It is committed by the first block of the previous epoch (see Block.NextValidators).
+func (vv Validators) Hash() hash.Hash {
+	addrs := make([]hash.Peer, 0, len(vv))
+	for addr := range vv {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	data := make([][]byte, 0, 2*len(addrs))
+	for _, addr := range addrs {
+		data = append(data, addr.Bytes(), common.IntToBytes(vv[addr]))
+	}
+	return hash.Of(data...)
+}
+
+// ToWire converts to simple map.
+func (vv Validators) ToWire() map[string]uint64 {
+	res := make(map[string]uint64, len(vv))
+
+	for addr, stake := range vv {
+		res[addr.Hex()] = stake
+	}
+
+	return res
+}
+
+// WireToValidators converts from wire.
+func WireToValidators(w map[string]uint64) Validators {
+	res := make(Validators, len(w))
+
+	for hex, stake := range w {
+		addr := hash.HexToPeer(hex)
+		res[addr] = stake
+	}
+
+	return res
+}
+
+// setCandidate registers or unregisters validator candidate in state.
+func setCandidate(db *state.DB, addr hash.Peer, is bool) {
+	db.SetValidator(addr, is)
+	if is {
+		setRecord(db, candidatesRegistry, hash.Hash(addr), hash.Hash(addr))
+	} else {
+		setRecord(db, candidatesRegistry, hash.Hash(addr), hash.Hash{})
+	}
+}
+
+/*
+ * Poset's methods:
+ */
+
+// CurrentEpoch returns number of the current epoch.
+func (p *Poset) CurrentEpoch() uint64 {
+	return p.state.Epoch
+}
+
+// TotalStake returns total stake of the current epoch validators.
+func (p *Poset) TotalStake() uint64 {
+	return p.state.TotalCap
+}
+
+// epochOf returns epoch number of frame.
+func (p *Poset) epochOf(frame uint64) uint64 {
+	return frame / p.state.Rules.EpochLen
+}
+
+// frameValidators returns validators of the frame epoch, so roots of any frame
+// are counted by the same stakes on each node regardless of events order.
+// Validators of the next epoch are committed by the first block of the current one,
+// the previous epoch ones are returned if the commit is delayed still.
+func (p *Poset) frameValidators(frame uint64) Validators {
+	for epoch := p.epochOf(frame); epoch > 0; epoch-- {
+		if vv := p.store.GetEpochValidators(epoch); vv != nil {
+			return vv
+		}
+	}
+	return p.store.GetEpochValidators(0)
+}
+
+// nextValidators calcs validators set of the next epoch from the balances
+// of the first block of the current one, so it doesn't depend on local DAG
+// progress and is verifiable by the block certificate (see Block.NextValidators).
+// Candidates are the registered validators (see setCandidate).
+// Validator should have min self-stake.
+func (p *Poset) nextValidators(balances hash.Hash) Validators {
+	db := p.store.StateDB(balances)
+
+	validators := make(Validators)
+	db.ForEachStorage(candidatesRegistry, func(_, value hash.Hash) bool {
+		addr := hash.Peer(value)
+		if !db.IsValidator(addr) || db.FreeBalance(addr) < p.state.Rules.MinStake {
+			return true
+		}
+		if stake := db.VoteBalance(addr); stake > 0 {
+			validators[addr] = stake
+		}
+		return true
+	})
+	if len(validators) == 0 {
+		p.Warnf("no stake for the next epoch, validators are left as is")
+		validators = p.state.Validators
+	}
+
+	return validators
+}
+
+// nextEpoch switches to epoch with validators committed by the first block
+// of the previous one (see frameValidators).
+// It is not safe for concurrent use.
+func (p *Poset) nextEpoch(epoch uint64) {
+	validators := p.frameValidators(epoch * p.state.Rules.EpochLen)
+
+	p.state.Epoch = epoch
+	p.state.EpochStartBlockN = p.state.LastBlockN + 1
+	p.state.Validators = validators
+	p.state.TotalCap = validators.Total()
+	p.saveState()
+
+	p.Infof("epoch %d: %d validators, total stake %d", epoch, len(validators), p.state.TotalCap)
+}
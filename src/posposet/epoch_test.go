@@ -0,0 +1,166 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+func TestPosetNextEpoch(t *testing.T) {
+	assertar := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	newbie, poor := hash.FakePeer(), hash.FakePeer()
+
+	rules := DefaultRules()
+	rules.EpochLen = 5
+	p, _, x := FakeRulesPoset(nodes, rules)
+
+	assertar.Equal(uint64(0), p.state.Epoch)
+	assertar.Equal(len(nodes), len(p.state.Validators))
+	assertar.Equal(uint64(len(nodes)), p.state.TotalCap)
+
+	event := func(creator hash.Peer, tx *inter.InternalTransaction) *inter.Event {
+		e := &inter.Event{
+			Index:                1,
+			Creator:              creator,
+			Parents:              hash.NewEvents(hash.ZeroEvent),
+			InternalTransactions: []*inter.InternalTransaction{tx},
+		}
+		x.SetEvent(e)
+		return e
+	}
+	events := inter.Events{
+		// nodes[0] sends whole stake to newbie
+		event(nodes[0], &inter.InternalTransaction{
+			Index:    0,
+			Amount:   1,
+			Receiver: newbie,
+		}),
+		// newbie registers
+		event(newbie, &inter.InternalTransaction{
+			Index: 0,
+			Kind:  inter.ValidatorRegistration,
+		}),
+		// poor registers without stake
+		event(poor, &inter.InternalTransaction{
+			Index: 0,
+			Kind:  inter.ValidatorRegistration,
+		}),
+		// nodes[1] leaves
+		event(nodes[1], &inter.InternalTransaction{
+			Index: 0,
+			Kind:  inter.ValidatorUnregistration,
+		}),
+	}
+	// the first block of epoch 0
+	p.store.SetBlock(inter.NewBlock(1, 1, 0, hash.Hash{}, hash.Hash{}, nil))
+	p.state.LastBlockN = 1
+
+	db := p.store.StateDB(p.state.Genesis)
+	p.applyTransactions(db, events)
+	balances, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+
+	// validators are fixed during epoch
+	assertar.Equal(uint64(1), p.state.Validators[nodes[0]])
+	assertar.Equal(uint64(1), p.state.Validators[nodes[1]])
+	assertar.Equal(uint64(0), p.state.Validators[newbie])
+
+	next := p.nextValidators(balances)
+	assertar.NotEqual(p.state.Validators.Hash(), next.Hash())
+	p.store.SetEpochValidators(1, next, 1)
+	p.nextEpoch(1)
+
+	assertar.Equal(uint64(1), p.state.Epoch)
+	assertar.Equal(uint64(2), p.state.EpochStartBlockN)
+	assertar.Equal(Validators{
+		nodes[2]: 1,
+		newbie:   1,
+	}, p.state.Validators)
+	assertar.Equal(uint64(2), p.state.TotalCap)
+
+	// and it is persistent
+	st := p.store.GetState()
+	assertar.Equal(p.state.Validators, st.Validators)
+	assertar.Equal(p.state.TotalCap, st.TotalCap)
+	assertar.Equal(p.state.Validators, p.store.GetEpochValidators(1))
+	assertar.Equal(uint64(1), p.store.GetEpochBlockN(1))
+}
+
+func TestPosetEpochOrder(t *testing.T) {
+	assertar := assert.New(t)
+
+	// the last node leaves validators, so the next epoch counts roots by other stakes
+	nodes, events := inter.GenEventsByNode(5, 60, 4, func(e *inter.Event, nodes []hash.Peer) {
+		if e.Creator == nodes[len(nodes)-1] && e.Index == 1 {
+			e.InternalTransactions = []*inter.InternalTransaction{{
+				Index: 0,
+				Kind:  inter.ValidatorUnregistration,
+			}}
+		}
+	})
+
+	rules := DefaultRules()
+	rules.EpochLen = 6
+
+	var all inter.Events
+	byHash := make(map[hash.Event]*inter.Event)
+	for _, ee := range events {
+		all = append(all, ee...)
+		for _, e := range ee {
+			byHash[e.Hash()] = e
+		}
+	}
+	// the same DAG in two different orders, parents first both:
+	// breadth-first and depth-first from the last events of nodes
+	var deep inter.Events
+	visited := hash.Events{}
+	var visit func(e *inter.Event)
+	visit = func(e *inter.Event) {
+		if !visited.Add(e.Hash()) {
+			return
+		}
+		for _, p := range e.Parents.Slice() {
+			if parent := byHash[p]; parent != nil {
+				visit(parent)
+			}
+		}
+		deep = append(deep, e)
+	}
+	for n := len(nodes) - 1; n >= 0; n-- {
+		ee := events[nodes[n]]
+		visit(ee[len(ee)-1])
+	}
+	orders := []inter.Events{all.ByParents(), deep}
+
+	posets := make([]*Poset, len(orders))
+	for i, ee := range orders {
+		p, _, input := FakeRulesPoset(nodes, rules)
+		for _, e := range ee {
+			input.SetEvent(e)
+			p.PushEventSync(e.Hash())
+		}
+		posets[i] = p
+	}
+
+	p0, p1 := posets[0], posets[1]
+	if !assertar.True(p0.state.Epoch > 1, "epoch boundary is crossed") {
+		return
+	}
+	assertar.NotContains(p0.store.GetEpochValidators(p0.state.Epoch), nodes[len(nodes)-1])
+
+	if !assertar.Equal(p0.state.LastBlockN, p1.state.LastBlockN, "blocks count") {
+		return
+	}
+	for b := uint64(1); b <= p0.state.LastBlockN; b++ {
+		if !assertar.Equal(p0.store.GetBlock(b), p1.store.GetBlock(b), "block %d", b) {
+			return
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 type EventSource interface {
 	HasEvent(hash.Event) bool
 	GetEvent(hash.Event) *inter.Event
+	DeleteEvent(hash.Event)
 }
 
 /*
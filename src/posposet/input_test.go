@@ -33,7 +33,7 @@ func NewEventStore(db kvdb.Database, cached bool) *EventStore {
 	}
 
 	if cached {
-		c, err := lru.New(cacheSize)
+		c, err := lru.New(DefaultCacheSize)
 		if err != nil {
 			panic(err)
 		}
@@ -75,6 +75,17 @@ func (s *EventStore) GetEvent(h hash.Event) *inter.Event {
 	return inter.WireToEvent(w)
 }
 
+// DeleteEvent removes stored event.
+func (s *EventStore) DeleteEvent(h hash.Event) {
+	if err := s.events.Delete(h.Bytes()); err != nil {
+		panic(err)
+	}
+
+	if s.eventsCache != nil {
+		s.eventsCache.Remove(h)
+	}
+}
+
 // HasEvent returns true if event exists.
 func (s *EventStore) HasEvent(h hash.Event) bool {
 	if s.eventsCache.Contains(h) {
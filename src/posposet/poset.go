@@ -19,10 +19,14 @@ type Poset struct {
 	processingWg   sync.WaitGroup
 	processingDone chan struct{}
 
-	newEventsCh chan hash.Event
-	onNewEvent  func(*inter.Event) // onNewEvent runs consensus calc from new event
+	newEventsCh  chan hash.Event
+	checkpointCh chan *checkpointApply
+	onNewEvent   func(*inter.Event) // onNewEvent runs consensus calc from new event
 
 	NewBlockCh chan uint64
+	// PruneBlocks is a number of the last blocks which events are retained.
+	// Zero means no pruning. It should be not less than Rules.EpochLen (see prune).
+	PruneBlocks uint64
 
 	logger.Instance
 }
@@ -37,7 +41,8 @@ func New(store *Store, input EventSource) *Poset {
 		input:  input,
 		frames: make(map[uint64]*Frame),
 
-		newEventsCh: make(chan hash.Event, buffSize),
+		newEventsCh:  make(chan hash.Event, buffSize),
+		checkpointCh: make(chan *checkpointApply),
 
 		Instance: logger.MakeInstance(),
 	}
@@ -74,6 +79,8 @@ func (p *Poset) Start() {
 			case e := <-p.newEventsCh:
 				event := p.input.GetEvent(e)
 				p.onNewEvent(event)
+			case req := <-p.checkpointCh:
+				req.result <- p.applyCheckpoint(req.checkpoint)
 			}
 		}
 	}()
@@ -98,6 +105,8 @@ func (p *Poset) PushEvent(e hash.Event) {
 // consensus is not safe for concurrent use.
 func (p *Poset) consensus(event *inter.Event) {
 	p.Debugf("consensus: start %s", event.String())
+	p.takeBlockSigns(event)
+
 	e := &Event{
 		Event: event,
 	}
@@ -111,17 +120,37 @@ func (p *Poset) consensus(event *inter.Event) {
 	p.setClothoCandidates(e, frame)
 
 	// process matured frames where ClothoCandidates have become Clothos
-	var ordered inter.Events
+	var (
+		ordered []inter.Events
+		blocks  []uint64
+	)
 	lastFinished := p.state.LastFinishedFrameN
 	for n := p.state.LastFinishedFrameN + 1; n+3 <= frame.Index; n++ {
 		if p.hasAtropos(n, frame.Index) {
 			p.Debugf("consensus: make new block %d from frame %d", p.state.LastBlockN+1, n)
 			events := p.topologicalOrdered(n)
-			block := inter.NewBlock(p.state.LastBlockN+1, events)
+			block, err := p.makeBlock(n, events)
+			if err != nil {
+				p.Fatal(err)
+			}
+			// the first block of epoch switches to it and commits validators of the next one,
+			// so they are known long before roots of the next epoch frames are counted (see frameValidators)
+			if p.epochOf(n) > p.state.Epoch {
+				p.nextEpoch(p.state.Epoch + 1)
+			}
+			var next Validators
+			if p.store.GetEpochValidators(p.state.Epoch+1) == nil {
+				next = p.nextValidators(block.StateRoot)
+				block.NextValidators = next.Hash()
+			}
 			p.store.SetEventsBlockNum(block.Index, events...)
 			p.store.SetBlock(block)
+			p.bindCertificate(block)
 			p.state.LastBlockN = block.Index
 			p.saveState()
+			if next != nil {
+				p.store.SetEpochValidators(p.state.Epoch+1, next, block.Index)
+			}
 			if p.NewBlockCh != nil {
 				p.NewBlockCh <- p.state.LastBlockN
 			}
@@ -129,18 +158,20 @@ func (p *Poset) consensus(event *inter.Event) {
 			// TODO: fix it
 			lastFinished = n // NOTE: are every event of prev frame there in block? (No)
 
-			ordered = append(ordered, events...)
+			ordered = append(ordered, events)
+			blocks = append(blocks, block.Index)
 		}
 	}
 
 	// balances changes
-	applyAt := p.frame(frame.Index+stateGap, true)
+	applyAt := p.frame(frame.Index+p.state.Rules.StateGap, true)
 	state := p.store.StateDB(applyAt.Balances)
-	p.applyTransactions(state, ordered)
-	p.applyRewards(state, ordered)
-	balances, err := state.Commit(true)
-	if err != nil {
-		p.Fatal(err)
+	balances, receipts := p.applyBlocks(state, ordered, blocks)
+	if len(blocks) == 0 {
+		balances = applyAt.Balances
+	}
+	for idx, r := range receipts {
+		p.store.SetReceipt(idx, r)
 	}
 	if applyAt.SetBalances(balances) {
 		p.Debugf("consensus: new state [%d]%s --> [%d]%s", frame.Index, frame.Balances.String(), applyAt.Index, balances.String())
@@ -154,9 +185,13 @@ func (p *Poset) consensus(event *inter.Event) {
 		p.Debugf("consensus: lastFinishedFrameN is %d", p.state.LastFinishedFrameN)
 	}
 
+	if len(blocks) > 0 {
+		p.prune()
+	}
+
 	// clean old frames
 	for i := range p.frames {
-		if i+stateGap < p.state.LastFinishedFrameN {
+		if i+p.state.Rules.StateGap < p.state.LastFinishedFrameN {
 			delete(p.frames, i)
 		}
 	}
@@ -205,12 +240,16 @@ func (p *Poset) checkIfRoot(e *Event) *Frame {
 		frame = p.frame(fnum, true)
 		frame.AddRootsOf(e.Hash(), roots)
 		//log.Debugf(" %s knows %s at frame %d", e.Hash().String(), roots.String(), frame.Index)
-		if isRoot = p.hasMajority(frame, roots); isRoot {
+		if isRoot = p.hasMajority(fnum, roots); isRoot {
 			frame = p.frame(fnum+1, true)
 			//log.Debugf(" %s is root of frame %d", e.Hash().String(), frame.Index)
 			break
 		}
 	}
+	if frame == nil {
+		p.Warnf("Event %s knows too old roots only. Skipped", e.String())
+		return nil
+	}
 	if !p.isEventValid(e, frame) {
 		return nil
 	}
@@ -245,7 +284,7 @@ func (p *Poset) setClothoCandidates(root *Event, frame *Frame) {
 			}
 		}
 		// check CC-condition
-		if p.hasTrust(frame, roots) {
+		if p.hasTrust(frame.Index, roots) {
 			prev.AddClothoCandidate(seen, seenCreator)
 			//log.Debugf("CC: %s from %s", seen.String(), seenCreator.String())
 		}
@@ -295,7 +334,7 @@ CLOTHO:
 						}
 					}
 
-					if diff%3 > 0 && p.hasMajority(prev, K) {
+					if diff%p.state.Rules.CoinRound > 0 && p.hasMajority(prev.Index, K) {
 						//log.Debugf("ATROPOS %s of frame %d", clotho.String(), frame.Index)
 						frame.SetAtropos(clotho, T)
 						has = true
@@ -345,10 +384,20 @@ func (p *Poset) collectParents(a *Event, res *Events, already hash.Events) {
 		if already.Contains(hash_) {
 			continue
 		}
-		f, _ := p.FrameOfEvent(hash_)
-		if _, ok := f.Atroposes[hash_]; ok {
+		// event is ordered by previous block with its parents already,
+		// it doesn't depend on frames cleaning, so blocks are the same on each node
+		if p.store.GetEventBlockNum(hash_) != nil {
+			continue
+		}
+		if p.store.GetEventFrame(hash_) == nil {
+			// pruned already
 			continue
 		}
+		if f, _ := p.FrameOfEvent(hash_); f != nil {
+			if _, ok := f.Atroposes[hash_]; ok {
+				continue
+			}
+		}
 
 		e := p.GetEvent(hash_)
 		e.consensusTime = a.consensusTime
@@ -67,6 +67,13 @@ func TestPoset(t *testing.T) {
 			p0 := posets[i]
 			st := p0.store.GetState()
 			t.Logf("poset%d: frame %d, block %d", i, st.LastFinishedFrameN, st.LastBlockN)
+			// check chain
+			for b := uint64(2); b <= p0.state.LastBlockN; b++ {
+				prev, block := p0.store.GetBlock(b-1), p0.store.GetBlock(b)
+				if !assertar.Equal(prev.Hash(), block.ParentHash, "parent of block %d", b) {
+					return
+				}
+			}
 			for j := i + 1; j < len(posets); j++ {
 				p1 := posets[j]
 
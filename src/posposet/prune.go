@@ -0,0 +1,72 @@
+package posposet
+
+/*
+ * Poset's methods:
+ */
+
+// LowestBlockN returns number of the lowest block
+// which events are retained still.
+func (p *Poset) LowestBlockN() uint64 {
+	return p.state.LowestBlockN
+}
+
+// prune deletes events and frames of blocks older than the last PruneBlocks ones.
+// Block headers, receipts, certificates and balances are kept.
+// Blocks of the current epoch are kept to serve checkpoint events (see GetCheckpoint),
+// so PruneBlocks less than epoch length doesn't make a sense.
+// It is not safe for concurrent use.
+func (p *Poset) prune() {
+	if p.PruneBlocks == 0 || p.state.LastBlockN <= p.PruneBlocks {
+		return
+	}
+
+	lowest := p.state.LastBlockN - p.PruneBlocks + 1
+	if lowest > p.state.EpochStartBlockN {
+		lowest = p.state.EpochStartBlockN
+	}
+	if lowest <= p.state.LowestBlockN {
+		return
+	}
+
+	for n := p.state.LowestBlockN; n < lowest; n++ {
+		block := p.store.GetBlock(n)
+		if block == nil {
+			p.Fatalf("block %d not found", n)
+		}
+		for _, e := range block.Events {
+			// event could be ordered again by later block
+			if b := p.store.GetEventBlockNum(e); b != nil && *b >= lowest {
+				continue
+			}
+			p.store.DeleteEventFrame(e)
+			p.store.DeleteEventBlockNum(e)
+			p.input.DeleteEvent(e)
+		}
+	}
+
+	p.pruneFrames(p.store.GetBlock(lowest - 1).Frame)
+
+	p.Debugf("prune: events of blocks %d-%d are deleted", p.state.LowestBlockN, lowest-1)
+	p.state.LowestBlockN = lowest
+	p.saveState()
+}
+
+// pruneFrames deletes frames up to the specified one which are not in use by consensus.
+// Frames are stored without gaps, so deletion stops at the previously pruned frame
+// and border frames in use now are deleted by the next pruning.
+func (p *Poset) pruneFrames(last uint64) {
+	if p.state.LastFinishedFrameN <= p.state.Rules.StateGap+1 {
+		return
+	}
+	// frame may be in use still
+	if inUse := p.state.LastFinishedFrameN - p.state.Rules.StateGap; last >= inUse {
+		last = inUse - 1
+	}
+
+	for n := last; n > 0; n-- {
+		if p.store.GetFrame(n) == nil {
+			break
+		}
+		p.store.DeleteFrame(n)
+	}
+}
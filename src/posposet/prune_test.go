@@ -0,0 +1,103 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+func TestPosetPrune(t *testing.T) {
+	assertar := assert.New(t)
+
+	nodes, events := inter.GenEventsByNode(5, 99, 3)
+	// blocks of the current epoch are not pruned
+	rules := DefaultRules()
+	rules.EpochLen = 5
+	p, s, input := FakeRulesPoset(nodes, rules)
+	p.PruneBlocks = rules.EpochLen
+
+	for _, ee := range events {
+		for _, e := range ee {
+			input.SetEvent(e)
+			p.PushEventSync(e.Hash())
+		}
+	}
+
+	lowest := p.LowestBlockN()
+	if !assertar.True(lowest > 1, "nothing is pruned") {
+		return
+	}
+	assertar.True(lowest <= p.LastBlockN()-p.PruneBlocks+1)
+	assertar.True(lowest <= p.state.EpochStartBlockN)
+	assertar.Equal(lowest, s.GetState().LowestBlockN)
+
+	// events which are ordered by retained blocks too
+	kept := hash.Events{}
+	for n := lowest; n <= p.LastBlockN(); n++ {
+		kept.Add(p.GetBlock(n).Events...)
+	}
+
+	for n := uint64(1); n <= p.LastBlockN(); n++ {
+		block := p.GetBlock(n)
+		if !assertar.NotNil(block, "block header %d is kept", n) {
+			return
+		}
+		for _, e := range block.Events {
+			if n < lowest && !kept.Contains(e) {
+				assertar.Nil(input.GetEvent(e), "event of block %d is pruned", n)
+				assertar.Nil(s.GetEventFrame(e), "event frame of block %d is pruned", n)
+				assertar.Nil(p.GetEventBlock(e), "event block of block %d is pruned", n)
+			} else {
+				assertar.NotNil(input.GetEvent(e), "event of block %d is kept", n)
+				assertar.NotNil(p.GetEventBlock(e), "event block of block %d is kept", n)
+			}
+		}
+	}
+
+	// frames of pruned blocks are deleted unless they are in use
+	for n := p.GetBlock(lowest - 1).Frame; n > 0; n-- {
+		if n+rules.StateGap < p.state.LastFinishedFrameN {
+			assertar.Nil(s.GetFrame(n), "frame %d is pruned", n)
+		}
+	}
+
+	// the last blocks of epochs commit validators of the next ones
+	if !assertar.True(p.state.Epoch > 0, "no epochs") {
+		return
+	}
+	for epoch := uint64(1); epoch <= p.state.Epoch; epoch++ {
+		block := p.GetBlock(s.GetEpochBlockN(epoch))
+		if !assertar.NotNil(block) {
+			return
+		}
+		assertar.Equal(s.GetEpochValidators(epoch).Hash(), block.NextValidators)
+	}
+}
+
+func TestPosetPruneFrames(t *testing.T) {
+	assertar := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer()}
+	p, s, _ := FakePoset(nodes)
+	gap := p.state.Rules.StateGap
+	for n := uint64(1); n <= 10+gap; n++ {
+		s.SetFrame(&Frame{Index: n})
+	}
+
+	// frames in use are kept
+	p.state.LastFinishedFrameN = 5 + gap
+	p.pruneFrames(7)
+	for n := uint64(1); n <= 10+gap; n++ {
+		assertar.Equal(n >= 5, s.GetFrame(n) != nil, "frame %d", n)
+	}
+
+	// border frames are deleted by the next pruning
+	p.state.LastFinishedFrameN = 10 + gap
+	p.pruneFrames(8)
+	for n := uint64(1); n <= 10+gap; n++ {
+		assertar.Equal(n > 8, s.GetFrame(n) != nil, "frame %d", n)
+	}
+}
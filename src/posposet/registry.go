@@ -0,0 +1,27 @@
+package posposet
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+)
+
+// Registries are fixed state accounts which keep consensus records
+// in their storage, so the records are a part of state root.
+var (
+	slashingRegistry   = hash.Peer(hash.Of([]byte("registry of applied fork proofs")))
+	candidatesRegistry = hash.Peer(hash.Of([]byte("registry of validator candidates")))
+)
+
+// setRecord writes record into registry.
+// Registry has non-zero nonce to not be deleted as empty account.
+func setRecord(db *state.DB, registry hash.Peer, key, value hash.Hash) {
+	if db.GetNonce(registry) == 0 {
+		db.SetNonce(registry, 1)
+	}
+	db.SetState(registry, key, value)
+}
+
+// getRecord reads record from registry.
+func getRecord(db *state.DB, registry hash.Peer, key hash.Hash) hash.Hash {
+	return db.GetState(registry, key)
+}
@@ -0,0 +1,165 @@
+package posposet
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/ordering"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
+)
+
+// RulesVersion is the last known version of consensus rules.
+const RulesVersion = 1
+
+// ConsensusRules are the consensus parameters all the net nodes should agree on.
+type ConsensusRules struct {
+	Version       uint32 // rules version, to change them by network upgrade
+	StateGap      uint64 // frame-delay to apply new balance
+	CoinRound     uint64 // each CoinRound-th round of Atropos election is a coin round
+	MajorityNum   uint64 // numerator of stake share to become root and Atropos
+	MajorityDenom uint64 // denominator of stake share to become root and Atropos
+	TrustNum      uint64 // numerator of stake share to become Clotho
+	TrustDenom    uint64 // denominator of stake share to become Clotho
+	EpochLen      uint64 // frames count of epoch, validators set is fixed during epoch
+	MinStake      uint64 // minimal self-stake of validator
+
+	MaxEventBytes   uint64 // max size of event in wire format, zero is unlimited
+	MaxEventTxns    uint64 // max count of internal and external txns of event, zero is unlimited
+	MaxEventParents uint64 // max count of event parents (includes self-parent), zero is unlimited
+}
+
+// DefaultRules returns current consensus rules.
+func DefaultRules() ConsensusRules {
+	return ConsensusRules{
+		Version:       RulesVersion,
+		StateGap:      3,
+		CoinRound:     3,
+		MajorityNum:   2,
+		MajorityDenom: 3,
+		TrustNum:      1,
+		TrustDenom:    3,
+		EpochLen:      100,
+		MinStake:      1,
+
+		MaxEventBytes:   1024 * 1024,
+		MaxEventTxns:    4096,
+		MaxEventParents: 10,
+	}
+}
+
+// Validate returns error if rules are inconsistent.
+func (r *ConsensusRules) Validate() error {
+	if r.Version < 1 || r.Version > RulesVersion {
+		return fmt.Errorf("unknown consensus rules version %d", r.Version)
+	}
+	if r.StateGap < 1 {
+		return fmt.Errorf("state gap shouldn't be zero")
+	}
+	// each CoinRound-th round is a coin round, so the first one decides nothing
+	if r.CoinRound < 2 {
+		return fmt.Errorf("coin round should be 2 at least")
+	}
+	if r.MajorityDenom < 1 || r.MajorityNum > r.MajorityDenom || r.MajorityNum <= r.MajorityDenom-r.MajorityNum {
+		return fmt.Errorf("majority share %d/%d is invalid, should be more than 1/2", r.MajorityNum, r.MajorityDenom)
+	}
+	if r.TrustDenom < 1 || r.TrustNum > r.TrustDenom {
+		return fmt.Errorf("trust share %d/%d is invalid", r.TrustNum, r.TrustDenom)
+	}
+	if shareLess(r.MajorityNum, r.MajorityDenom, r.TrustNum, r.TrustDenom) {
+		return fmt.Errorf("majority share %d/%d is less than trust share %d/%d",
+			r.MajorityNum, r.MajorityDenom, r.TrustNum, r.TrustDenom)
+	}
+	if r.EpochLen < 1 {
+		return fmt.Errorf("epoch length shouldn't be zero")
+	}
+	if r.MinStake < 1 {
+		return fmt.Errorf("min stake shouldn't be zero")
+	}
+	if r.MaxEventParents == 1 {
+		return fmt.Errorf("event parents limit should allow other parents than self-parent")
+	}
+	return nil
+}
+
+// EventLimits returns max sizes of event.
+func (r *ConsensusRules) EventLimits() ordering.Limits {
+	return ordering.Limits{
+		MaxBytes:   int(r.MaxEventBytes),
+		MaxTxns:    int(r.MaxEventTxns),
+		MaxParents: int(r.MaxEventParents),
+	}
+}
+
+// Hash returns hash of rules to compare them with other nodes.
+func (r *ConsensusRules) Hash() hash.Hash {
+	var pbf proto.Buffer
+	pbf.SetDeterministic(true)
+	if err := pbf.Marshal(r.ToWire()); err != nil {
+		panic(err)
+	}
+	return hash.Of(pbf.Bytes())
+}
+
+// ToWire converts to proto.Message.
+func (r *ConsensusRules) ToWire() *wire.ConsensusRules {
+	return &wire.ConsensusRules{
+		Version:       r.Version,
+		StateGap:      r.StateGap,
+		CoinRound:     r.CoinRound,
+		MajorityNum:   r.MajorityNum,
+		MajorityDenom: r.MajorityDenom,
+		TrustNum:      r.TrustNum,
+		TrustDenom:    r.TrustDenom,
+		EpochLen:      r.EpochLen,
+		MinStake:      r.MinStake,
+
+		MaxEventBytes:   r.MaxEventBytes,
+		MaxEventTxns:    r.MaxEventTxns,
+		MaxEventParents: r.MaxEventParents,
+	}
+}
+
+// WireToConsensusRules converts from wire.
+func WireToConsensusRules(w *wire.ConsensusRules) ConsensusRules {
+	if w == nil {
+		return ConsensusRules{}
+	}
+	return ConsensusRules{
+		Version:       w.Version,
+		StateGap:      w.StateGap,
+		CoinRound:     w.CoinRound,
+		MajorityNum:   w.MajorityNum,
+		MajorityDenom: w.MajorityDenom,
+		TrustNum:      w.TrustNum,
+		TrustDenom:    w.TrustDenom,
+		EpochLen:      w.EpochLen,
+		MinStake:      w.MinStake,
+
+		MaxEventBytes:   w.MaxEventBytes,
+		MaxEventTxns:    w.MaxEventTxns,
+		MaxEventParents: w.MaxEventParents,
+	}
+}
+
+/*
+ * Poset's methods:
+ */
+
+// GetRules returns consensus rules.
+func (p *Poset) GetRules() ConsensusRules {
+	return p.state.Rules
+}
+
+/*
+ * Utils:
+ */
+
+// shareLess returns true if aNum/aDenom < bNum/bDenom.
+func shareLess(aNum, aDenom, bNum, bDenom uint64) bool {
+	a := new(big.Int).Mul(new(big.Int).SetUint64(aNum), new(big.Int).SetUint64(bDenom))
+	b := new(big.Int).Mul(new(big.Int).SetUint64(bNum), new(big.Int).SetUint64(aDenom))
+	return a.Cmp(b) < 0
+}
@@ -0,0 +1,76 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestConsensusRules(t *testing.T) {
+	assertar := assert.New(t)
+
+	rules := DefaultRules()
+	assertar.NoError(rules.Validate())
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer()}
+	balances := map[hash.Peer]uint64{nodes[0]: 1, nodes[1]: 1}
+
+	genesis := func(rules ConsensusRules, economy Economy) (hash.Hash, error) {
+		store := NewMemStore()
+		defer store.Close()
+		if err := store.ApplyGenesis(balances, economy, rules); err != nil {
+			return hash.Hash{}, err
+		}
+		p := New(store, nil)
+		p.Bootstrap()
+		return p.GetGenesisHash(), nil
+	}
+
+	h1, err := genesis(rules, Economy{})
+	assertar.NoError(err)
+
+	other := rules
+	other.StateGap++
+	h2, err := genesis(other, Economy{})
+	assertar.NoError(err)
+	assertar.NotEqual(h1, h2, "rules should affect genesis hash")
+
+	other = rules
+	other.MaxEventBytes++
+	h3, err := genesis(other, Economy{})
+	assertar.NoError(err)
+	assertar.NotEqual(h1, h3, "event limits should affect genesis hash")
+	assertar.Equal(int(other.MaxEventBytes), other.EventLimits().MaxBytes)
+
+	h4, err := genesis(rules, Economy{BlockReward: 1})
+	assertar.NoError(err)
+	assertar.NotEqual(h1, h4, "economy should affect genesis hash")
+
+	invalid := rules
+	invalid.MajorityDenom = 0
+	_, err = genesis(invalid, Economy{})
+	assertar.Error(err)
+
+	for name, invalid := range map[string]func(*ConsensusRules){
+		"coin round":          func(r *ConsensusRules) { r.CoinRound = 1 },
+		"no majority":         func(r *ConsensusRules) { r.MajorityNum, r.MajorityDenom = 1, 2 },
+		"trust over majority": func(r *ConsensusRules) { r.TrustNum, r.TrustDenom = 3, 4 },
+	} {
+		other := rules
+		invalid(&other)
+		_, err = genesis(other, Economy{})
+		assertar.Error(err, name)
+	}
+
+	invalid = rules
+	invalid.MaxEventParents = 1
+	_, err = genesis(invalid, Economy{})
+	assertar.Error(err)
+
+	invalid = rules
+	invalid.Version = RulesVersion + 1
+	_, err = genesis(invalid, Economy{})
+	assertar.Error(err)
+}
@@ -2,25 +2,20 @@ package posposet
 
 import (
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
-	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
-// stateGap is a frame-delay to apply new balance.
-// TODO: move this magic number to mainnet config
-const stateGap = 3
-
 // stakeCounter is for PoS balances accumulator.
 type stakeCounter struct {
-	balances *state.DB
-	amount   uint64
-	goal     uint64
+	validators Validators
+	amount     uint64
+	goal       uint64
 }
 
 func (s *stakeCounter) Count(node hash.Peer) {
 	if s.IsGoalAchieved() {
 		return // no sense to count further
 	}
-	s.amount += s.balances.VoteBalance(node)
+	s.amount += s.validators[node]
 }
 
 func (s *stakeCounter) IsGoalAchieved() bool {
@@ -33,33 +28,41 @@ func (s *stakeCounter) IsGoalAchieved() bool {
 
 // StakeOf returns last stake balance of peer.
 func (p *Poset) StakeOf(addr hash.Peer) uint64 {
-	f := p.frame(p.state.LastFinishedFrameN+stateGap, true)
+	f := p.frame(p.state.LastFinishedFrameN+p.state.Rules.StateGap, true)
 	db := p.store.StateDB(f.Balances)
 	return db.VoteBalance(addr)
 }
 
-func (p *Poset) newStakeCounter(frame *Frame, goal uint64) *stakeCounter {
-	db := p.store.StateDB(frame.Balances)
+// NonceOf returns last nonce (next expected txn index) of peer.
+func (p *Poset) NonceOf(addr hash.Peer) uint64 {
+	f := p.frame(p.state.LastFinishedFrameN+p.state.Rules.StateGap, true)
+	db := p.store.StateDB(f.Balances)
+	return db.GetNonce(addr)
+}
 
+// newStakeCounter makes counter of the frame epoch validators stakes
+// to achieve num/denom share of them.
+func (p *Poset) newStakeCounter(frame, num, denom uint64) *stakeCounter {
+	validators := p.frameValidators(frame)
 	return &stakeCounter{
-		balances: db,
-		amount:   0,
-		goal:     goal,
+		validators: validators,
+		amount:     0,
+		goal:       mulDiv(validators.Total(), num, denom),
 	}
 }
 
-func (p *Poset) hasMajority(frame *Frame, roots EventsByPeer) bool {
-	stake := p.newStakeCounter(frame,
-		p.state.TotalCap*2/3)
+// hasMajority returns true if roots of frame have majority of stake.
+func (p *Poset) hasMajority(frame uint64, roots EventsByPeer) bool {
+	stake := p.newStakeCounter(frame, p.state.Rules.MajorityNum, p.state.Rules.MajorityDenom)
 	for node := range roots {
 		stake.Count(node)
 	}
 	return stake.IsGoalAchieved()
 }
 
-func (p *Poset) hasTrust(frame *Frame, roots EventsByPeer) bool {
-	stake := p.newStakeCounter(frame,
-		p.state.TotalCap*1/3)
+// hasTrust returns true if roots of frame have trust share of stake.
+func (p *Poset) hasTrust(frame uint64, roots EventsByPeer) bool {
+	stake := p.newStakeCounter(frame, p.state.Rules.TrustNum, p.state.Rules.TrustDenom)
 	for node := range roots {
 		stake.Count(node)
 	}
@@ -14,6 +14,12 @@ type State struct {
 	LastBlockN         uint64
 	Genesis            hash.Hash
 	TotalCap           uint64
+	Economy            Economy
+	Rules              ConsensusRules
+	Epoch              uint64
+	EpochStartBlockN   uint64
+	Validators         Validators
+	LowestBlockN       uint64
 }
 
 // ToWire converts to proto.Message.
@@ -23,6 +29,12 @@ func (s *State) ToWire() *wire.State {
 		LastBlockN:         s.LastBlockN,
 		Genesis:            s.Genesis.Bytes(),
 		TotalCap:           s.TotalCap,
+		Economy:            s.Economy.ToWire(),
+		Rules:              s.Rules.ToWire(),
+		Epoch:              s.Epoch,
+		EpochStartBlockN:   s.EpochStartBlockN,
+		Validators:         s.Validators.ToWire(),
+		LowestBlockN:       s.LowestBlockN,
 	}
 }
 
@@ -36,6 +48,12 @@ func WireToState(w *wire.State) *State {
 		LastBlockN:         w.LastBlockN,
 		Genesis:            hash.FromBytes(w.Genesis),
 		TotalCap:           w.TotalCap,
+		Economy:            WireToEconomy(w.Economy),
+		Rules:              WireToConsensusRules(w.Rules),
+		Epoch:              w.Epoch,
+		EpochStartBlockN:   w.EpochStartBlockN,
+		Validators:         WireToValidators(w.Validators),
+		LowestBlockN:       w.LowestBlockN,
 	}
 }
 
@@ -71,8 +89,12 @@ func (p *Poset) Bootstrap() {
 	p.reconsensusFromFrame(p.state.LastFinishedFrameN+1, start.Balances)
 }
 
+// GetGenesisHash returns hash of genesis, economy and consensus rules.
+// So nodes with different rules don't accept each other.
 func (p *Poset) GetGenesisHash() hash.Hash {
-	return p.state.Genesis
+	economy := p.state.Economy.Hash()
+	rules := p.state.Rules.Hash()
+	return hash.Of(p.state.Genesis.Bytes(), economy.Bytes(), rules.Bytes())
 }
 
 // GenesisHash calcs hash of genesis balances.
@@ -80,7 +102,7 @@ func genesisHash(balances map[hash.Peer]uint64) hash.Hash {
 	s := NewMemStore()
 	defer s.Close()
 
-	if err := s.ApplyGenesis(balances); err != nil {
+	if err := s.ApplyGenesis(balances, Economy{}, DefaultRules()); err != nil {
 		logger.Get().Fatal(err)
 	}
 
@@ -12,7 +12,8 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
-const cacheSize = 500 // TODO: Move it to config later
+// DefaultCacheSize is a default size of store LRU caches.
+const DefaultCacheSize = 500
 
 // Store is a poset persistent storage working over physical key-value database.
 type Store struct {
@@ -24,6 +25,10 @@ type Store struct {
 		Blocks      kvdb.Database `table:"block_"`
 		Event2Frame kvdb.Database `table:"event2frame_"`
 		Event2Block kvdb.Database `table:"event2block_"`
+		Receipts    kvdb.Database `table:"receipt_"`
+		Certs       kvdb.Database `table:"cert_"`
+		Epochs      kvdb.Database `table:"epoch_"`
+		Trie        kvdb.Database `table:"balance_"`
 		Balances    state.Database
 	}
 	cache struct {
@@ -36,16 +41,17 @@ type Store struct {
 }
 
 // NewStore creates store over key-value db.
-func NewStore(db kvdb.Database, cached bool) *Store {
+// Zero cacheSize means no cache.
+func NewStore(db kvdb.Database, cacheSize int) *Store {
 	s := &Store{
 		physicalDB: db,
 		Instance:   logger.MakeInstance(),
 	}
 
 	kvdb.MigrateTables(&s.table, s.physicalDB)
-	s.table.Balances = state.NewDatabase(kvdb.NewTable(s.physicalDB, "balance_"))
+	s.table.Balances = state.NewDatabase(s.table.Trie)
 
-	if cached {
+	if cacheSize > 0 {
 		kvdb.MigrateCaches(&s.cache, func() interface{} {
 			c, err := lru.New(cacheSize)
 			if err != nil {
@@ -61,7 +67,7 @@ func NewStore(db kvdb.Database, cached bool) *Store {
 // NewMemStore creates store over memory map.
 func NewMemStore() *Store {
 	db := kvdb.NewMemDatabase()
-	return NewStore(db, false)
+	return NewStore(db, 0)
 }
 
 // Close leaves underlying database.
@@ -72,14 +78,17 @@ func (s *Store) Close() {
 }
 
 // ApplyGenesis stores initial state.
-func (s *Store) ApplyGenesis(balances map[hash.Peer]uint64) error {
+func (s *Store) ApplyGenesis(balances map[hash.Peer]uint64, economy Economy, rules ConsensusRules) error {
 	if balances == nil {
 		return fmt.Errorf("balances shouldn't be nil")
 	}
+	if err := rules.Validate(); err != nil {
+		return err
+	}
 
 	st := s.GetState()
 	if st != nil {
-		if st.Genesis == genesisHash(balances) {
+		if st.Genesis == genesisHash(balances) && st.Economy == economy && st.Rules == rules {
 			return nil
 		}
 		return fmt.Errorf("other genesis has applied already")
@@ -88,17 +97,32 @@ func (s *Store) ApplyGenesis(balances map[hash.Peer]uint64) error {
 	st = &State{
 		LastFinishedFrameN: 0,
 		TotalCap:           0,
+		Economy:            economy,
+		Rules:              rules,
+		Epoch:              0,
+		EpochStartBlockN:   1,
+		Validators:         make(Validators, len(balances)),
+		LowestBlockN:       1,
 	}
 
+	// genesis accounts are registered validators
 	genesis := s.StateDB(hash.Hash{})
 	for addr, balance := range balances {
 		genesis.SetBalance(hash.Peer(addr), balance)
+		setCandidate(genesis, hash.Peer(addr), true)
+		if balance >= rules.MinStake {
+			st.Validators[addr] = balance
+		}
 		st.TotalCap += balance
 	}
 
 	if st.TotalCap < uint64(len(balances)) {
 		return fmt.Errorf("balance shouldn't be zero")
 	}
+	if len(st.Validators) < 1 {
+		return fmt.Errorf("no one has min stake %d", rules.MinStake)
+	}
+	st.TotalCap = st.Validators.Total()
 
 	var err error
 	st.Genesis, err = genesis.Commit(true)
@@ -106,6 +130,7 @@ func (s *Store) ApplyGenesis(balances map[hash.Peer]uint64) error {
 		return err
 	}
 
+	s.SetEpochValidators(0, st.Validators, 0)
 	s.SetState(st)
 	return nil
 }
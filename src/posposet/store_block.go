@@ -59,3 +59,28 @@ func (s *Store) GetEventBlockNum(e hash.Event) *uint64 {
 	val := common.BytesToInt(buf)
 	return &val
 }
+
+// DeleteEventBlockNum removes num of block includes event.
+func (s *Store) DeleteEventBlockNum(e hash.Event) {
+	key := e.Bytes()
+	if err := s.table.Event2Block.Delete(key); err != nil {
+		s.Fatal(err)
+	}
+
+	if s.cache.Event2Block != nil {
+		s.cache.Event2Block.Remove(e)
+	}
+}
+
+// SetCertificate stores collected signatures of block.
+func (s *Store) SetCertificate(n uint64, c *inter.BlockCertificate) {
+	key := common.IntToBytes(n)
+	s.set(s.table.Certs, key, c.ToWire())
+}
+
+// GetCertificate returns collected signatures of block.
+func (s *Store) GetCertificate(n uint64) *inter.BlockCertificate {
+	key := common.IntToBytes(n)
+	w, _ := s.get(s.table.Certs, key, &wire.BlockCertificate{}).(*wire.BlockCertificate)
+	return inter.WireToBlockCertificate(w)
+}
@@ -0,0 +1,40 @@
+package posposet
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
+)
+
+// SetEpochValidators stores validators of epoch
+// with number of the block which has committed them (0 for genesis).
+func (s *Store) SetEpochValidators(epoch uint64, vv Validators, blockN uint64) {
+	key := common.IntToBytes(epoch)
+	s.set(s.table.Epochs, key, &wire.Epoch{
+		Validators: vv.ToWire(),
+		BlockN:     blockN,
+	})
+}
+
+// GetEpochValidators returns stored validators of epoch.
+func (s *Store) GetEpochValidators(epoch uint64) Validators {
+	w := s.getEpoch(epoch)
+	if w == nil {
+		return nil
+	}
+	return WireToValidators(w.Validators)
+}
+
+// GetEpochBlockN returns number of the block which has committed validators of epoch.
+func (s *Store) GetEpochBlockN(epoch uint64) uint64 {
+	w := s.getEpoch(epoch)
+	if w == nil {
+		return 0
+	}
+	return w.BlockN
+}
+
+func (s *Store) getEpoch(epoch uint64) *wire.Epoch {
+	key := common.IntToBytes(epoch)
+	w, _ := s.get(s.table.Epochs, key, &wire.Epoch{}).(*wire.Epoch)
+	return w
+}
@@ -31,6 +31,18 @@ func (s *Store) GetFrame(n uint64) *Frame {
 	return WireToFrame(w)
 }
 
+// DeleteFrame removes stored frame.
+func (s *Store) DeleteFrame(n uint64) {
+	key := common.IntToBytes(n)
+	if err := s.table.Frames.Delete(key); err != nil {
+		s.Fatal(err)
+	}
+
+	if s.cache.Frames != nil {
+		s.cache.Frames.Remove(n)
+	}
+}
+
 // SetEventFrame stores frame num of event.
 func (s *Store) SetEventFrame(e hash.Event, frame uint64) {
 	key := e.Bytes()
@@ -65,3 +77,15 @@ func (s *Store) GetEventFrame(e hash.Event) *uint64 {
 	val := common.BytesToInt(buf)
 	return &val
 }
+
+// DeleteEventFrame removes frame num of event.
+func (s *Store) DeleteEventFrame(e hash.Event) {
+	key := e.Bytes()
+	if err := s.table.Event2Frame.Delete(key); err != nil {
+		s.Fatal(err)
+	}
+
+	if s.cache.Event2Frame != nil {
+		s.cache.Event2Frame.Remove(e)
+	}
+}
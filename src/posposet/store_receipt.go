@@ -0,0 +1,18 @@
+package posposet
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// SetReceipt stores result of internal transaction.
+func (s *Store) SetReceipt(idx hash.Transaction, r *inter.Receipt) {
+	s.set(s.table.Receipts, idx.Bytes(), r.ToWire())
+}
+
+// GetReceipt returns stored result of internal transaction.
+func (s *Store) GetReceipt(idx hash.Transaction) *inter.Receipt {
+	w, _ := s.get(s.table.Receipts, idx.Bytes(), &wire.Receipt{}).(*wire.Receipt)
+	return inter.WireToReceipt(w)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
 	"github.com/Fantom-foundation/go-lachesis/src/state"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
 )
 
 // StateDB returns state database.
@@ -15,6 +16,26 @@ func (s *Store) StateDB(from hash.Hash) *state.DB {
 	return db
 }
 
+// GetStateNode returns node of balances trie.
+func (s *Store) GetStateNode(h hash.Hash) []byte {
+	data, err := s.table.Balances.TrieDB().Node(h)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// StateSync makes scheduler to download balances trie.
+func (s *Store) StateSync(root hash.Hash) *trie.Sync {
+	return state.NewStateSync(root, s.table.Trie)
+}
+
+// CommitStateSync writes downloaded nodes of balances trie.
+func (s *Store) CommitStateSync(sched *trie.Sync) error {
+	_, err := sched.Commit(s.table.Trie)
+	return err
+}
+
 // SetState stores state.
 // State is seldom read; so no cache.
 func (s *Store) SetState(st *State) {
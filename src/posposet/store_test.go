@@ -34,14 +34,14 @@ func Test_IntToBytes(t *testing.T) {
  */
 
 func BenchmarkStoreWithCache(b *testing.B) {
-	benchmarkStore(b, true)
+	benchmarkStore(b, DefaultCacheSize)
 }
 
 func BenchmarkNoCachedStore(b *testing.B) {
-	benchmarkStore(b, false)
+	benchmarkStore(b, 0)
 }
 
-func benchmarkStore(b *testing.B, cached bool) {
+func benchmarkStore(b *testing.B, cacheSize int) {
 	dir, err := ioutil.TempDir("", "poset-bench")
 	if err != nil {
 		panic(err)
@@ -62,13 +62,13 @@ func benchmarkStore(b *testing.B, cached bool) {
 	}
 	defer ondisk.Close()
 
-	input := NewEventStore(kvdb.NewBadgerDatabase(ondisk), cached)
+	input := NewEventStore(kvdb.NewBadgerDatabase(ondisk), cacheSize > 0)
 	defer input.Close()
-	store := NewStore(kvdb.NewBadgerDatabase(ondisk), cached)
+	store := NewStore(kvdb.NewBadgerDatabase(ondisk), cacheSize)
 	defer input.Close()
 
 	nodes, events := inter.GenEventsByNode(5, 100*b.N, 3)
-	poset := benchPoset(nodes, input, store, cached)
+	poset := benchPoset(nodes, input, store, cacheSize > 0)
 
 	b.ResetTimer()
 
@@ -86,7 +86,7 @@ func benchPoset(nodes []hash.Peer, input EventSource, store *Store, cached bool)
 		balances[addr] = uint64(1)
 	}
 
-	if err := store.ApplyGenesis(balances); err != nil {
+	if err := store.ApplyGenesis(balances, Economy{}, DefaultRules()); err != nil {
 		panic(err)
 	}
 
@@ -9,10 +9,16 @@ and the payment of all rewards.
 */
 
 import (
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
+// appliedProof marks applied fork proof in slashing registry.
+var appliedProof = hash.Of([]byte("slashed"))
+
 // isEventValid validates event according to frame state.
 func (p *Poset) isEventValid(e *Event, f *Frame) bool {
 	// NOTE: issue
@@ -23,36 +29,196 @@ func (p *Poset) isEventValid(e *Event, f *Frame) bool {
 	return true
 }
 
-// applyTransactions execs ordered txns on state.
+// applyBlocks execs txns, slashing and rewards of ordered blocks on state
+// and returns state root of the last one (empty if there are no blocks).
+// Each block is committed, so receipts refer to the state of their own block.
+func (p *Poset) applyBlocks(db *state.DB, ordered []inter.Events, blocks []uint64) (root hash.Hash, receipts map[hash.Transaction]*inter.Receipt) {
+	receipts = make(map[hash.Transaction]*inter.Receipt)
+
+	for i, events := range ordered {
+		fees, rr := p.applyTransactions(db, events)
+		burnt := p.applySlashing(db, events)
+		issued := p.applyRewards(db, events, fees)
+		p.Debugf("consensus: block %d fees %d, burnt %d, issued %d", blocks[i], fees, burnt, issued)
+
+		var err error
+		root, err = db.Commit(true)
+		if err != nil {
+			p.Fatal(err)
+		}
+		for idx, r := range rr {
+			r.BlockN = blocks[i]
+			r.StateRoot = root
+			receipts[idx] = r
+		}
+	}
+	return
+}
+
+// applyTransactions execs ordered txns on state and returns collected fees
+// and receipts of txns (without block number and state root).
+// Event creator pays fee for all the event's transactions (see Economy),
+// its internal transactions are skipped if the fee is not fully paid.
+// Txn is applied only if its index equals to the sender's nonce,
+// the nonce is incremented even if the txn is skipped for other reasons.
+// Txns with nonce gap get skipped receipts (they are overridden if the txn
+// is applied later), replayed txns don't override receipts of the original.
 // TODO: fine of invalid txns
-// TODO: transaction fees
-func (p *Poset) applyTransactions(db *state.DB, ordered inter.Events) {
+func (p *Poset) applyTransactions(db *state.DB, ordered inter.Events) (fees uint64, receipts map[hash.Transaction]*inter.Receipt) {
+	receipts = make(map[hash.Transaction]*inter.Receipt)
+
 	for _, e := range ordered {
 		sender := e.Creator
-		for _, tx := range e.InternalTransactions {
-			receiver := tx.Receiver
 
-			if db.FreeBalance(sender) < tx.Amount {
-				p.Warnf("cannot send %d from %s to %s: balance is insufficient, skipped", tx.Amount, sender.String(), receiver.String())
+		fee := p.state.Economy.EventFee(e)
+		var unpaid error
+		if free := db.FreeBalance(sender); free < fee {
+			unpaid = fmt.Errorf("cannot pay fee %d: balance %d is insufficient", fee, free)
+			p.Warnf("cannot pay fee %d by %s: balance is insufficient, txns skipped", fee, sender.String())
+			db.SubBalance(sender, free)
+			fees += free
+		} else {
+			db.SubBalance(sender, fee)
+			fees += fee
+		}
+
+		for _, tx := range e.InternalTransactions {
+			if nonce := db.GetNonce(sender); tx.Index != nonce {
+				p.Warnf("txn %d from %s has wrong nonce, expected %d, skipped", tx.Index, sender.String(), nonce)
+				if tx.Index > nonce {
+					receipts[inter.TransactionHashOf(sender, tx.Index)] = &inter.Receipt{
+						Status: inter.TxnSkipped,
+						Reason: fmt.Sprintf("wrong nonce, expected %d", nonce),
+					}
+				}
 				continue
 			}
+			db.SetNonce(sender, tx.Index+1)
+
+			err := unpaid
+			if err == nil {
+				switch tx.Kind {
+				case inter.StakeTransfer:
+					err = p.applyTransfer(db, sender, tx)
+				case inter.ValidatorRegistration, inter.ValidatorUnregistration:
+					err = p.applyRegistration(db, sender, tx)
+				default:
+					err = fmt.Errorf("unknown txn kind %d", tx.Kind)
+				}
+			}
+
+			r := &inter.Receipt{
+				Status: inter.TxnApplied,
+			}
+			if err != nil {
+				p.Warnf("txn %d from %s skipped: %s", tx.Index, sender.String(), err)
+				r.Status = inter.TxnSkipped
+				r.Reason = err.Error()
+			}
+			receipts[inter.TransactionHashOf(sender, tx.Index)] = r
+		}
+	}
+	return
+}
+
+// applyTransfer transfers or delegates stake.
+func (p *Poset) applyTransfer(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction) error {
+	receiver := tx.Receiver
+
+	if free := db.FreeBalance(sender); free < tx.Amount {
+		return fmt.Errorf("cannot send %d to %s: balance %d is insufficient", tx.Amount, receiver.String(), free)
+	}
+
+	if !db.Exist(receiver) {
+		db.CreateAccount(receiver)
+	}
+
+	if tx.UntilBlock == 0 {
+		p.Infof("transfer %d from %s to %s", tx.Amount, sender.String(), receiver.String())
+		db.Transfer(sender, receiver, tx.Amount)
+	} else {
+		p.Infof("delegate %d from %s to %s for %d", tx.Amount, sender.String(), receiver.String(), tx.UntilBlock)
+		db.Delegate(sender, receiver, tx.Amount, tx.UntilBlock)
+	}
+	return nil
+}
 
-			if !db.Exist(receiver) {
-				db.CreateAccount(receiver)
+// applyRegistration registers or unregisters sender as validator.
+// Validators set changes from the next epoch (see nextEpoch).
+func (p *Poset) applyRegistration(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction) error {
+	if tx.Kind == inter.ValidatorUnregistration {
+		p.Infof("unregister %s as validator", sender.String())
+		setCandidate(db, sender, false)
+		return nil
+	}
+
+	if self := db.FreeBalance(sender); self < p.state.Rules.MinStake {
+		return fmt.Errorf("cannot register as validator: self-stake %d is less than %d", self, p.state.Rules.MinStake)
+	}
+	p.Infof("register %s as validator", sender.String())
+	setCandidate(db, sender, true)
+	return nil
+}
+
+// applySlashing burns free stake of creators proved to be cheaters
+// and returns burnt amount. Each proof is applied once, it is marked
+// in the slashing registry.
+func (p *Poset) applySlashing(db *state.DB, ordered inter.Events) (burnt uint64) {
+	for _, e := range ordered {
+		for _, proof := range e.ForkProofs {
+			if err := proof.Verify(); err != nil {
+				p.Warnf("fork proof from %s is invalid: %s, skipped", e.Creator.String(), err)
+				continue
 			}
 
-			if tx.UntilBlock == 0 {
-				p.Infof("transfer %d from %s to %s", tx.Amount, sender.String(), receiver.String())
-				db.Transfer(sender, receiver, tx.Amount)
-			} else {
-				p.Infof("delegate %d from %s to %s for %d", tx.Amount, sender.String(), receiver.String(), tx.UntilBlock)
-				db.Delegate(sender, receiver, tx.Amount, tx.UntilBlock)
+			cheater := proof.Cheater()
+			key := proof.Hash()
+			if getRecord(db, slashingRegistry, key) == appliedProof {
+				p.Debugf("fork proof %s is applied already, skipped", key.String())
+				continue
 			}
+			setRecord(db, slashingRegistry, key, appliedProof)
+
+			amount := db.FreeBalance(cheater)
+			p.Infof("slash %d of %s for fork %s", amount, cheater.String(), proof.Second.Hash().String())
+			db.SubBalance(cheater, amount)
+			burnt += amount
 		}
 	}
+	return
 }
 
-// applyRewards calcs block rewards.
-func (p *Poset) applyRewards(db *state.DB, ordered inter.Events) {
-	// TODO: implement it
+// applyRewards splits block reward and collected fees between block participants
+// proportionally to their stakes and returns issued amount.
+// Participant is a creator of at least one block event.
+func (p *Poset) applyRewards(db *state.DB, ordered inter.Events, fees uint64) (issued uint64) {
+	pool := addSat(p.state.Economy.BlockReward, fees)
+	if pool == 0 {
+		return
+	}
+
+	var total uint64
+	stakes := make(map[hash.Peer]uint64)
+	for _, e := range ordered {
+		if _, ok := stakes[e.Creator]; ok {
+			continue
+		}
+		stake := db.VoteBalance(e.Creator)
+		stakes[e.Creator] = stake
+		total += stake
+	}
+	if total == 0 {
+		return
+	}
+
+	for participant, stake := range stakes {
+		reward := mulDiv(pool, stake, total)
+		if reward == 0 {
+			continue
+		}
+		p.Debugf("reward %d to %s", reward, participant.String())
+		db.AddBalance(participant, reward)
+		issued += reward
+	}
+	return
 }
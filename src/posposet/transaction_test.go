@@ -1,10 +1,12 @@
 package posposet
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
@@ -55,4 +57,239 @@ func TestPosetTxn(t *testing.T) {
 		uint64(2), p.StakeOf(nodes[1]),
 		"balance of %s", nodes[1].String())
 
+	r := p.GetReceipt(inter.TransactionHashOf(nodes[0], 0))
+	if assert.NotNil(t, r) {
+		assert.Equal(t, inter.TxnApplied, r.Status)
+		assert.NotEqual(t, uint64(0), r.BlockN)
+		assert.NotEqual(t, hash.Hash{}, r.StateRoot)
+	}
+}
+
+func TestPosetReceiptStateRoot(t *testing.T) {
+	assertar := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer()}
+	p, _, _ := FakeEconomyPoset(nodes, 10, Economy{})
+
+	transfer := func(index uint64) inter.Events {
+		return inter.Events{
+			&inter.Event{
+				Creator: nodes[0],
+				InternalTransactions: []*inter.InternalTransaction{
+					{
+						Index:    index,
+						Amount:   1,
+						Receiver: nodes[1],
+					},
+				},
+			},
+		}
+	}
+	ordered := []inter.Events{transfer(0), transfer(1)}
+
+	db := p.store.StateDB(p.state.Genesis)
+	root, receipts := p.applyBlocks(db, ordered, []uint64{1, 2})
+	if !assertar.Len(receipts, 2) {
+		return
+	}
+	r1 := receipts[inter.TransactionHashOf(nodes[0], 0)]
+	r2 := receipts[inter.TransactionHashOf(nodes[0], 1)]
+	assertar.Equal(uint64(1), r1.BlockN)
+	assertar.Equal(uint64(2), r2.BlockN)
+	assertar.NotEqual(r1.StateRoot, r2.StateRoot)
+	assertar.Equal(root, r2.StateRoot)
+
+	// state of the first block
+	assertar.Equal(uint64(10+1), p.store.StateDB(r1.StateRoot).FreeBalance(nodes[1]))
+	assertar.Equal(uint64(10+2), p.store.StateDB(root).FreeBalance(nodes[1]))
+}
+
+func TestPosetTxnFees(t *testing.T) {
+	assertar := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	economy := Economy{
+		InternalTxnFee:     10,
+		ExternalTxnByteFee: 1,
+		BlockReward:        60,
+	}
+	p, _, _ := FakeEconomyPoset(nodes, 1000, economy)
+
+	events := inter.Events{
+		&inter.Event{
+			Creator: nodes[0],
+			InternalTransactions: []*inter.InternalTransaction{
+				{
+					Index:    0,
+					Amount:   100,
+					Receiver: nodes[2],
+				},
+			},
+			ExternalTransactions: [][]byte{
+				[]byte("12345"),
+			},
+		},
+		&inter.Event{
+			Creator: nodes[1],
+		},
+	}
+
+	db := p.store.StateDB(p.state.Genesis)
+
+	fees, receipts := p.applyTransactions(db, events)
+	assertar.Equal(uint64(10+5), fees)
+	assertar.Equal(&inter.Receipt{Status: inter.TxnApplied}, receipts[inter.TransactionHashOf(nodes[0], 0)])
+	assertar.Equal(uint64(1000-15-100), db.FreeBalance(nodes[0]))
+	assertar.Equal(uint64(1000), db.FreeBalance(nodes[1]))
+	assertar.Equal(uint64(1000+100), db.FreeBalance(nodes[2]))
+
+	// nodes[2] has no events, so it is not a participant
+	issued := p.applyRewards(db, events, fees)
+	assertar.Equal(uint64(885*75/1885+1000*75/1885), issued)
+	assertar.Equal(uint64(885+885*75/1885), db.FreeBalance(nodes[0]))
+	assertar.Equal(uint64(1000+1000*75/1885), db.FreeBalance(nodes[1]))
+	assertar.Equal(uint64(1100), db.FreeBalance(nodes[2]))
+}
+
+func TestEconomyFeeOverflow(t *testing.T) {
+	assertar := assert.New(t)
+
+	economy := Economy{
+		InternalTxnFee:     math.MaxUint64 / 2,
+		ExternalTxnByteFee: math.MaxUint64 / 2,
+	}
+
+	assertar.Equal(uint64(math.MaxUint64), economy.ExternalTxnFee([]byte("123")))
+	assertar.Equal(uint64(math.MaxUint64), economy.EventFee(&inter.Event{
+		InternalTransactions: make([]*inter.InternalTransaction, 3),
+	}))
+	assertar.Equal(uint64(math.MaxUint64), economy.EventFee(&inter.Event{
+		ExternalTransactions: [][]byte{{1}, {2}, {3}},
+	}))
+}
+
+func TestPosetTxnNonces(t *testing.T) {
+	assertar := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer()}
+	economy := Economy{
+		InternalTxnFee: 10,
+	}
+	p, _, _ := FakeEconomyPoset(nodes, 55, economy)
+
+	transfer := func(index, amount uint64) *inter.InternalTransaction {
+		return &inter.InternalTransaction{
+			Index:    index,
+			Amount:   amount,
+			Receiver: nodes[1],
+		}
+	}
+	events := inter.Events{
+		&inter.Event{
+			Creator: nodes[0],
+			InternalTransactions: []*inter.InternalTransaction{
+				transfer(0, 1),
+				// replay
+				transfer(0, 1),
+				// gap
+				transfer(3, 1),
+				transfer(1, 1),
+			},
+		},
+		&inter.Event{
+			Creator: nodes[0],
+			InternalTransactions: []*inter.InternalTransaction{
+				// insufficient balance, but nonce is used
+				transfer(2, 1000),
+			},
+		},
+		&inter.Event{
+			Creator: nodes[0],
+			InternalTransactions: []*inter.InternalTransaction{
+				// fee is not paid, but nonce is used
+				transfer(3, 1),
+			},
+		},
+		&inter.Event{
+			Creator: nodes[0],
+			InternalTransactions: []*inter.InternalTransaction{
+				// gap
+				transfer(7, 1),
+			},
+		},
+	}
+
+	db := p.store.StateDB(p.state.Genesis)
+
+	fees, receipts := p.applyTransactions(db, events)
+	assertar.Equal(uint64(40+10+3), fees)
+	assertar.Len(receipts, 5)
+	if r := receipts[inter.TransactionHashOf(nodes[0], 7)]; assertar.NotNil(r, "receipt of gap") {
+		assertar.Equal(inter.TxnSkipped, r.Status)
+		assertar.Equal("wrong nonce, expected 4", r.Reason)
+	}
+	for i, status := range []inter.TxnStatus{inter.TxnApplied, inter.TxnApplied, inter.TxnSkipped, inter.TxnSkipped} {
+		r := receipts[inter.TransactionHashOf(nodes[0], uint64(i))]
+		if !assertar.NotNil(r, "receipt %d", i) {
+			continue
+		}
+		assertar.Equal(status, r.Status, "receipt %d", i)
+		assertar.Equal(status == inter.TxnSkipped, r.Reason != "", "receipt %d", i)
+	}
+	assertar.Equal(uint64(4), db.GetNonce(nodes[0]))
+	assertar.Equal(uint64(0), db.GetNonce(nodes[1]))
+	assertar.Equal(uint64(0), db.FreeBalance(nodes[0]))
+	assertar.Equal(uint64(55+2), db.FreeBalance(nodes[1]))
+}
+
+func TestPosetSlashing(t *testing.T) {
+	assertar := assert.New(t)
+
+	key := crypto.GenerateKey()
+	cheater := hash.PeerOfPubkey(key.Public())
+	nodes := []hash.Peer{cheater, hash.FakePeer()}
+
+	p, _, _ := FakeEconomyPoset(nodes, 1000, Economy{})
+
+	fork := func(n uint64) *inter.EventHeader {
+		e := &inter.Event{
+			Index:       1,
+			Creator:     cheater,
+			Parents:     hash.NewEvents(hash.ZeroEvent),
+			LamportTime: inter.Timestamp(n),
+		}
+		if err := e.SignBy(key); err != nil {
+			t.Fatal(err)
+		}
+		return e.Header()
+	}
+
+	events := inter.Events{
+		&inter.Event{
+			Creator: nodes[1],
+			ForkProofs: []*inter.ForkProof{
+				inter.NewForkProof(key.Public(), fork(1), fork(2)),
+				// invalid
+				inter.NewForkProof(key.Public(), fork(1), fork(1)),
+			},
+		},
+	}
+
+	db := p.store.StateDB(p.state.Genesis)
+
+	burnt := p.applySlashing(db, events)
+	assertar.Equal(uint64(1000), burnt)
+	assertar.Equal(uint64(0), db.FreeBalance(cheater))
+	assertar.Equal(uint64(1000), db.FreeBalance(nodes[1]))
+
+	// the same proof again, even after empty cheater account is deleted
+	root, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+	db = p.store.StateDB(root)
+	db.AddBalance(cheater, 500)
+	burnt = p.applySlashing(db, events)
+	assertar.Equal(uint64(0), burnt)
+	assertar.Equal(uint64(500), db.FreeBalance(cheater))
 }
@@ -20,21 +20,209 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
-type State struct {
-	LastFinishedFrameN   uint64   `protobuf:"varint,1,opt,name=LastFinishedFrameN,proto3" json:"LastFinishedFrameN,omitempty"`
-	LastBlockN           uint64   `protobuf:"varint,2,opt,name=LastBlockN,proto3" json:"LastBlockN,omitempty"`
-	Genesis              []byte   `protobuf:"bytes,3,opt,name=Genesis,proto3" json:"Genesis,omitempty"`
-	TotalCap             uint64   `protobuf:"varint,4,opt,name=TotalCap,proto3" json:"TotalCap,omitempty"`
+type Economy struct {
+	InternalTxnFee       uint64   `protobuf:"varint,1,opt,name=InternalTxnFee,proto3" json:"InternalTxnFee,omitempty"`
+	ExternalTxnByteFee   uint64   `protobuf:"varint,2,opt,name=ExternalTxnByteFee,proto3" json:"ExternalTxnByteFee,omitempty"`
+	BlockReward          uint64   `protobuf:"varint,3,opt,name=BlockReward,proto3" json:"BlockReward,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *Economy) Reset()         { *m = Economy{} }
+func (m *Economy) String() string { return proto.CompactTextString(m) }
+func (*Economy) ProtoMessage()    {}
+func (*Economy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a888679467bb7853, []int{0}
+}
+
+func (m *Economy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Economy.Unmarshal(m, b)
+}
+func (m *Economy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Economy.Marshal(b, m, deterministic)
+}
+func (m *Economy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Economy.Merge(m, src)
+}
+func (m *Economy) XXX_Size() int {
+	return xxx_messageInfo_Economy.Size(m)
+}
+func (m *Economy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Economy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Economy proto.InternalMessageInfo
+
+func (m *Economy) GetInternalTxnFee() uint64 {
+	if m != nil {
+		return m.InternalTxnFee
+	}
+	return 0
+}
+
+func (m *Economy) GetExternalTxnByteFee() uint64 {
+	if m != nil {
+		return m.ExternalTxnByteFee
+	}
+	return 0
+}
+
+func (m *Economy) GetBlockReward() uint64 {
+	if m != nil {
+		return m.BlockReward
+	}
+	return 0
+}
+
+type ConsensusRules struct {
+	Version              uint32   `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	StateGap             uint64   `protobuf:"varint,2,opt,name=StateGap,proto3" json:"StateGap,omitempty"`
+	CoinRound            uint64   `protobuf:"varint,3,opt,name=CoinRound,proto3" json:"CoinRound,omitempty"`
+	MajorityNum          uint64   `protobuf:"varint,4,opt,name=MajorityNum,proto3" json:"MajorityNum,omitempty"`
+	MajorityDenom        uint64   `protobuf:"varint,5,opt,name=MajorityDenom,proto3" json:"MajorityDenom,omitempty"`
+	TrustNum             uint64   `protobuf:"varint,6,opt,name=TrustNum,proto3" json:"TrustNum,omitempty"`
+	TrustDenom           uint64   `protobuf:"varint,7,opt,name=TrustDenom,proto3" json:"TrustDenom,omitempty"`
+	EpochLen             uint64   `protobuf:"varint,8,opt,name=EpochLen,proto3" json:"EpochLen,omitempty"`
+	MinStake             uint64   `protobuf:"varint,9,opt,name=MinStake,proto3" json:"MinStake,omitempty"`
+	MaxEventBytes        uint64   `protobuf:"varint,10,opt,name=MaxEventBytes,proto3" json:"MaxEventBytes,omitempty"`
+	MaxEventTxns         uint64   `protobuf:"varint,11,opt,name=MaxEventTxns,proto3" json:"MaxEventTxns,omitempty"`
+	MaxEventParents      uint64   `protobuf:"varint,12,opt,name=MaxEventParents,proto3" json:"MaxEventParents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsensusRules) Reset()         { *m = ConsensusRules{} }
+func (m *ConsensusRules) String() string { return proto.CompactTextString(m) }
+func (*ConsensusRules) ProtoMessage()    {}
+func (*ConsensusRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a888679467bb7853, []int{1}
+}
+
+func (m *ConsensusRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusRules.Unmarshal(m, b)
+}
+func (m *ConsensusRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusRules.Marshal(b, m, deterministic)
+}
+func (m *ConsensusRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusRules.Merge(m, src)
+}
+func (m *ConsensusRules) XXX_Size() int {
+	return xxx_messageInfo_ConsensusRules.Size(m)
+}
+func (m *ConsensusRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusRules proto.InternalMessageInfo
+
+func (m *ConsensusRules) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetStateGap() uint64 {
+	if m != nil {
+		return m.StateGap
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetCoinRound() uint64 {
+	if m != nil {
+		return m.CoinRound
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetMajorityNum() uint64 {
+	if m != nil {
+		return m.MajorityNum
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetMajorityDenom() uint64 {
+	if m != nil {
+		return m.MajorityDenom
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetTrustNum() uint64 {
+	if m != nil {
+		return m.TrustNum
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetTrustDenom() uint64 {
+	if m != nil {
+		return m.TrustDenom
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetEpochLen() uint64 {
+	if m != nil {
+		return m.EpochLen
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetMinStake() uint64 {
+	if m != nil {
+		return m.MinStake
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetMaxEventBytes() uint64 {
+	if m != nil {
+		return m.MaxEventBytes
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetMaxEventTxns() uint64 {
+	if m != nil {
+		return m.MaxEventTxns
+	}
+	return 0
+}
+
+func (m *ConsensusRules) GetMaxEventParents() uint64 {
+	if m != nil {
+		return m.MaxEventParents
+	}
+	return 0
+}
+
+type State struct {
+	LastFinishedFrameN   uint64            `protobuf:"varint,1,opt,name=LastFinishedFrameN,proto3" json:"LastFinishedFrameN,omitempty"`
+	LastBlockN           uint64            `protobuf:"varint,2,opt,name=LastBlockN,proto3" json:"LastBlockN,omitempty"`
+	Genesis              []byte            `protobuf:"bytes,3,opt,name=Genesis,proto3" json:"Genesis,omitempty"`
+	TotalCap             uint64            `protobuf:"varint,4,opt,name=TotalCap,proto3" json:"TotalCap,omitempty"`
+	Economy              *Economy          `protobuf:"bytes,5,opt,name=Economy,proto3" json:"Economy,omitempty"`
+	Rules                *ConsensusRules   `protobuf:"bytes,6,opt,name=Rules,proto3" json:"Rules,omitempty"`
+	Epoch                uint64            `protobuf:"varint,7,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	EpochStartBlockN     uint64            `protobuf:"varint,8,opt,name=EpochStartBlockN,proto3" json:"EpochStartBlockN,omitempty"`
+	Validators           map[string]uint64 `protobuf:"bytes,9,rep,name=Validators,proto3" json:"Validators,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LowestBlockN         uint64            `protobuf:"varint,10,opt,name=LowestBlockN,proto3" json:"LowestBlockN,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
 func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a888679467bb7853, []int{0}
+	return fileDescriptor_a888679467bb7853, []int{2}
 }
 
 func (m *State) XXX_Unmarshal(b []byte) error {
@@ -83,21 +271,140 @@ func (m *State) GetTotalCap() uint64 {
 	return 0
 }
 
+func (m *State) GetEconomy() *Economy {
+	if m != nil {
+		return m.Economy
+	}
+	return nil
+}
+
+func (m *State) GetRules() *ConsensusRules {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *State) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *State) GetEpochStartBlockN() uint64 {
+	if m != nil {
+		return m.EpochStartBlockN
+	}
+	return 0
+}
+
+func (m *State) GetValidators() map[string]uint64 {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *State) GetLowestBlockN() uint64 {
+	if m != nil {
+		return m.LowestBlockN
+	}
+	return 0
+}
+
+type Epoch struct {
+	Validators           map[string]uint64 `protobuf:"bytes,1,rep,name=Validators,proto3" json:"Validators,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	BlockN               uint64            `protobuf:"varint,2,opt,name=BlockN,proto3" json:"BlockN,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Epoch) Reset()         { *m = Epoch{} }
+func (m *Epoch) String() string { return proto.CompactTextString(m) }
+func (*Epoch) ProtoMessage()    {}
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a888679467bb7853, []int{3}
+}
+
+func (m *Epoch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Epoch.Unmarshal(m, b)
+}
+func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Epoch.Marshal(b, m, deterministic)
+}
+func (m *Epoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Epoch.Merge(m, src)
+}
+func (m *Epoch) XXX_Size() int {
+	return xxx_messageInfo_Epoch.Size(m)
+}
+func (m *Epoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Epoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Epoch proto.InternalMessageInfo
+
+func (m *Epoch) GetValidators() map[string]uint64 {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *Epoch) GetBlockN() uint64 {
+	if m != nil {
+		return m.BlockN
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterType((*Economy)(nil), "wire.Economy")
+	proto.RegisterType((*ConsensusRules)(nil), "wire.ConsensusRules")
 	proto.RegisterType((*State)(nil), "wire.State")
+	proto.RegisterMapType((map[string]uint64)(nil), "wire.State.ValidatorsEntry")
+	proto.RegisterType((*Epoch)(nil), "wire.Epoch")
+	proto.RegisterMapType((map[string]uint64)(nil), "wire.Epoch.ValidatorsEntry")
 }
 
 func init() { proto.RegisterFile("state.proto", fileDescriptor_a888679467bb7853) }
 
 var fileDescriptor_a888679467bb7853 = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2e, 0x2e, 0x49, 0x2c,
-	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x29, 0xcf, 0x2c, 0x4a, 0x55, 0xea, 0x65,
-	0xe4, 0x62, 0x0d, 0x06, 0x89, 0x0a, 0xe9, 0x71, 0x09, 0xf9, 0x24, 0x16, 0x97, 0xb8, 0x65, 0xe6,
-	0x65, 0x16, 0x67, 0xa4, 0xa6, 0xb8, 0x15, 0x25, 0xe6, 0xa6, 0xfa, 0x49, 0x30, 0x2a, 0x30, 0x6a,
-	0xb0, 0x04, 0x61, 0x91, 0x11, 0x92, 0xe3, 0xe2, 0x02, 0x89, 0x3a, 0xe5, 0xe4, 0x27, 0x67, 0xfb,
-	0x49, 0x30, 0x81, 0xd5, 0x21, 0x89, 0x08, 0x49, 0x70, 0xb1, 0xbb, 0xa7, 0xe6, 0xa5, 0x16, 0x67,
-	0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x04, 0xc1, 0xb8, 0x42, 0x52, 0x5c, 0x1c, 0x21, 0xf9,
-	0x25, 0x89, 0x39, 0xce, 0x89, 0x05, 0x12, 0x2c, 0x60, 0x7d, 0x70, 0x7e, 0x12, 0x1b, 0xd8, 0x71,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x30, 0x2f, 0x9b, 0x04, 0xab, 0x00, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6f, 0x1a, 0x3d,
+	0x10, 0xd5, 0x86, 0x40, 0xc2, 0x2c, 0x24, 0x91, 0x15, 0x7d, 0xb2, 0xf2, 0x55, 0x15, 0x42, 0x55,
+	0x8b, 0x72, 0xe0, 0x40, 0x2f, 0x55, 0xab, 0x5e, 0x42, 0x21, 0xaa, 0x44, 0x50, 0xb5, 0xa0, 0xdc,
+	0x5d, 0x18, 0x29, 0x5b, 0x16, 0x1b, 0xd9, 0xde, 0x00, 0xe7, 0xfe, 0x85, 0xde, 0xfa, 0x77, 0xfa,
+	0xc3, 0x2a, 0x8f, 0xd7, 0x64, 0xa1, 0x51, 0x4f, 0xbd, 0xf9, 0xbd, 0x79, 0x5e, 0xbf, 0x99, 0x37,
+	0x00, 0xb1, 0xb1, 0xc2, 0x62, 0x77, 0xa5, 0x95, 0x55, 0xec, 0x78, 0x9d, 0x6a, 0x6c, 0x7f, 0x8f,
+	0xe0, 0x64, 0x30, 0x53, 0x52, 0x2d, 0xb7, 0xec, 0x35, 0x9c, 0x7d, 0x96, 0x16, 0xb5, 0x14, 0xd9,
+	0x74, 0x23, 0x87, 0x88, 0x3c, 0x6a, 0x45, 0x9d, 0xe3, 0xe4, 0x80, 0x65, 0x5d, 0x60, 0x83, 0xcd,
+	0x8e, 0xb9, 0xd9, 0x5a, 0x74, 0xda, 0x23, 0xd2, 0x3e, 0x53, 0x61, 0x2d, 0x88, 0x6f, 0x32, 0x35,
+	0x5b, 0x24, 0xb8, 0x16, 0x7a, 0xce, 0x2b, 0x24, 0x2c, 0x53, 0xed, 0x1f, 0x15, 0x38, 0xeb, 0x2b,
+	0x69, 0x50, 0x9a, 0xdc, 0x24, 0x79, 0x86, 0x86, 0x71, 0x38, 0xb9, 0x47, 0x6d, 0x52, 0x25, 0xc9,
+	0x45, 0x33, 0x09, 0x90, 0x5d, 0xc1, 0xe9, 0xc4, 0xf5, 0x71, 0x2b, 0x56, 0xc5, 0xa3, 0x3b, 0xcc,
+	0x5e, 0x40, 0xbd, 0xaf, 0x52, 0x99, 0xa8, 0x5c, 0x86, 0x87, 0x9e, 0x08, 0x67, 0xe4, 0x4e, 0x7c,
+	0x53, 0x3a, 0xb5, 0xdb, 0x71, 0xbe, 0xe4, 0xc7, 0xde, 0x48, 0x89, 0x62, 0xaf, 0xa0, 0x19, 0xe0,
+	0x27, 0x94, 0x6a, 0xc9, 0xab, 0xa4, 0xd9, 0x27, 0x9d, 0x83, 0xa9, 0xce, 0x8d, 0x75, 0x1f, 0xa9,
+	0x79, 0x07, 0x01, 0xb3, 0x97, 0x00, 0x74, 0xf6, 0xd7, 0x4f, 0xa8, 0x5a, 0x62, 0xdc, 0xdd, 0xc1,
+	0x4a, 0xcd, 0x1e, 0x46, 0x28, 0xf9, 0xa9, 0xbf, 0x1b, 0xb0, 0xab, 0xdd, 0xa5, 0x72, 0x62, 0xc5,
+	0x02, 0x79, 0xdd, 0xd7, 0x02, 0xf6, 0xce, 0x36, 0x83, 0x47, 0x94, 0xd6, 0xcd, 0xd5, 0x70, 0x08,
+	0xce, 0x4a, 0x24, 0x6b, 0x43, 0x23, 0x10, 0xd3, 0x8d, 0x34, 0x3c, 0x26, 0xd1, 0x1e, 0xc7, 0x3a,
+	0x70, 0x1e, 0xf0, 0x17, 0xa1, 0x51, 0x5a, 0xc3, 0x1b, 0x24, 0x3b, 0xa4, 0xdb, 0xbf, 0x2a, 0x50,
+	0xa5, 0xd1, 0xba, 0xc8, 0x47, 0xc2, 0xd8, 0x61, 0x2a, 0x53, 0xf3, 0x80, 0xf3, 0xa1, 0x16, 0x4b,
+	0x1c, 0x17, 0xeb, 0xf1, 0x4c, 0xc5, 0x4d, 0xc1, 0xb1, 0x94, 0xf1, 0xb8, 0x48, 0xa9, 0xc4, 0xb8,
+	0x74, 0x6f, 0x51, 0xa2, 0x49, 0x0d, 0xa5, 0xd4, 0x48, 0x02, 0xa4, 0xd9, 0x2a, 0x2b, 0xb2, 0xbe,
+	0x58, 0x15, 0x01, 0xed, 0x30, 0x7b, 0xb3, 0xdb, 0x55, 0xca, 0x25, 0xee, 0x35, 0xbb, 0x6e, 0x89,
+	0xbb, 0x05, 0x99, 0x84, 0x2a, 0xbb, 0x86, 0x2a, 0x6d, 0x11, 0xa5, 0x13, 0xf7, 0x2e, 0xbd, 0x6c,
+	0x7f, 0xc3, 0x12, 0x2f, 0x61, 0x97, 0x50, 0xa5, 0x00, 0x8a, 0xac, 0x3c, 0x60, 0xd7, 0x70, 0x41,
+	0x87, 0x89, 0x15, 0x3a, 0xb4, 0xe1, 0xe3, 0xfa, 0x83, 0x67, 0x1f, 0x00, 0xee, 0x45, 0x96, 0xce,
+	0x85, 0x55, 0xda, 0xf0, 0x7a, 0xab, 0xd2, 0x89, 0x7b, 0xff, 0xfb, 0x27, 0x69, 0x7a, 0xdd, 0xa7,
+	0xea, 0x40, 0x5a, 0xbd, 0x4d, 0x4a, 0x72, 0x97, 0xd8, 0x48, 0xad, 0x71, 0x37, 0x2b, 0x1f, 0xeb,
+	0x1e, 0x77, 0xf5, 0x11, 0xce, 0x0f, 0x3e, 0xc1, 0x2e, 0xa0, 0xb2, 0xc0, 0x2d, 0x25, 0x50, 0x4f,
+	0xdc, 0xd1, 0xf5, 0xf1, 0x28, 0xb2, 0x3c, 0xfc, 0x10, 0x3d, 0x78, 0x7f, 0xf4, 0x2e, 0x6a, 0xff,
+	0x8c, 0x8a, 0x16, 0x0f, 0x9c, 0x46, 0x65, 0xa7, 0x24, 0xf8, 0xab, 0xd3, 0xff, 0xa0, 0xb6, 0x97,
+	0x67, 0xed, 0x9f, 0xb8, 0xfb, 0x5a, 0xa3, 0xbf, 0xa3, 0xb7, 0xbf, 0x07, 0x00, 0x0f, 0xfd, 0x51,
+	0xdd, 0x9d, 0x04, 0x00, 0x00,
 }
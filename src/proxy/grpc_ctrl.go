@@ -99,9 +99,10 @@ func (p *grpcCtrlProxy) GetTxnInfo(_ context.Context, req *internal.TransactionR
 	h := hash.HexToTransactionHash(req.Hex)
 
 	var (
-		txn   *inter.InternalTransaction
-		event *inter.Event
-		block *inter.Block
+		txn     *inter.InternalTransaction
+		event   *inter.Event
+		block   *inter.Block
+		receipt *inter.Receipt
 	)
 
 	txn, event = p.node.GetInternalTxn(h)
@@ -113,10 +114,15 @@ func (p *grpcCtrlProxy) GetTxnInfo(_ context.Context, req *internal.TransactionR
 		block = p.consensus.GetEventBlock(event.Hash())
 	}
 
+	if block != nil {
+		receipt = p.consensus.GetReceipt(h)
+	}
+
 	return &internal.TransactionResponse{
-		Txn:   txn.ToWire(),
-		Event: event.ToWire(),
-		Block: block.ToWire(),
+		Txn:     txn.ToWire(),
+		Event:   event.ToWire(),
+		Block:   block.ToWire(),
+		Receipt: receipt.ToWire(),
 	}, nil
 }
 
@@ -142,3 +148,10 @@ func (p *grpcCtrlProxy) SetLogLevel(_ context.Context, req *internal.LogLevel) (
 	logger.SetLevel(req.Level)
 	return &empty.Empty{}, nil
 }
+
+// GetEmissionStats returns counts of emitted events by reasons.
+func (p *grpcCtrlProxy) GetEmissionStats(_ context.Context, _ *empty.Empty) (*internal.EmissionStats, error) {
+	return &internal.EmissionStats{
+		Counts: p.node.EmissionStats(),
+	}, nil
+}
@@ -87,7 +87,7 @@ func testGrpcCtrlCalls(t *testing.T, listen network.ListenFunc, opts ...grpc.Dia
 			GetInternalTxn(h).
 			Return(nil, nil)
 
-		_, _, _, err := client.GetTxnInfo(h)
+		_, _, _, _, err := client.GetTxnInfo(h)
 		assertar.Error(err)
 	})
 
@@ -114,7 +114,7 @@ func testGrpcCtrlCalls(t *testing.T, listen network.ListenFunc, opts ...grpc.Dia
 			GetEventBlock(event0.Hash()).
 			Return(nil)
 
-		txn1, event1, _, err := client.GetTxnInfo(h)
+		txn1, event1, _, _, err := client.GetTxnInfo(h)
 		if !assertar.NoError(err) {
 			return
 		}
@@ -123,6 +123,48 @@ func testGrpcCtrlCalls(t *testing.T, listen network.ListenFunc, opts ...grpc.Dia
 		assertar.EqualValues(event0.Hash(), event1.Hash())
 	})
 
+	t.Run("ordered transaction", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		h := hash.FakeTransaction()
+		txn0 := &inter.InternalTransaction{
+			Index:    1,
+			Amount:   rand.Uint64(),
+			Receiver: peer,
+		}
+		event0 := &inter.Event{
+			Index:   1,
+			Creator: hash.FakePeer(),
+			Parents: hash.Events{},
+		}
+		block0 := inter.NewBlock(1, 1, 0, hash.FakeHash(), hash.FakeHash(), inter.Events{event0})
+		receipt0 := &inter.Receipt{
+			Status:    inter.TxnApplied,
+			BlockN:    block0.Index,
+			StateRoot: hash.FakeHash(),
+		}
+
+		node.EXPECT().
+			GetInternalTxn(h).
+			Return(txn0, event0)
+
+		consensus.EXPECT().
+			GetEventBlock(event0.Hash()).
+			Return(block0)
+
+		consensus.EXPECT().
+			GetReceipt(h).
+			Return(receipt0)
+
+		_, _, block1, receipt1, err := client.GetTxnInfo(h)
+		if !assertar.NoError(err) {
+			return
+		}
+
+		assertar.EqualValues(block0, block1)
+		assertar.EqualValues(receipt0, receipt1)
+	})
+
 	t.Run("get balance of self", func(t *testing.T) {
 		assertar := assert.New(t)
 
@@ -165,4 +207,22 @@ func testGrpcCtrlCalls(t *testing.T, listen network.ListenFunc, opts ...grpc.Dia
 		assertar.NoError(err)
 		assertar.Equal(logger.Get().GetLevel(), logger.GetLevel(l))
 	})
+
+	t.Run("get emission stats", func(t *testing.T) {
+		assertar := assert.New(t)
+
+		stats := map[string]uint64{
+			"emitted": 2,
+			"idle":    2,
+		}
+		node.EXPECT().
+			EmissionStats().
+			Return(stats)
+
+		got, err := client.GetEmissionStats()
+		if !assertar.NoError(err) {
+			return
+		}
+		assertar.Equal(stats, got)
+	})
 }
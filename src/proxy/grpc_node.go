@@ -107,7 +107,7 @@ func (p *grpcNodeProxy) SendTo(receiver hash.Peer, index, amount, until uint64)
 	return hash.HexToTransactionHash(resp.Hex), nil
 }
 
-func (p *grpcNodeProxy) GetTxnInfo(t hash.Transaction) (*inter.InternalTransaction, *inter.Event, *inter.Block, error) {
+func (p *grpcNodeProxy) GetTxnInfo(t hash.Transaction) (*inter.InternalTransaction, *inter.Event, *inter.Block, *inter.Receipt, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
 	defer cancel()
 
@@ -117,12 +117,13 @@ func (p *grpcNodeProxy) GetTxnInfo(t hash.Transaction) (*inter.InternalTransacti
 
 	resp, err := p.client.GetTxnInfo(ctx, &req)
 	if err != nil {
-		return nil, nil, nil, unwrapGrpcErr(err)
+		return nil, nil, nil, nil, unwrapGrpcErr(err)
 	}
 
 	return inter.WireToInternalTransaction(resp.Txn),
 		inter.WireToEvent(resp.Event),
 		inter.WireToBlock(resp.Block),
+		inter.WireToReceipt(resp.Receipt),
 		nil
 }
 
@@ -141,6 +142,18 @@ func (p *grpcNodeProxy) SetLogLevel(l string) error {
 	return nil
 }
 
+func (p *grpcNodeProxy) GetEmissionStats() (map[string]uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	resp, err := p.client.GetEmissionStats(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, unwrapGrpcErr(err)
+	}
+
+	return resp.Counts, nil
+}
+
 func unwrapGrpcErr(err error) error {
 	st := status.Convert(err)
 	return errors.New(st.Message())
@@ -32,10 +32,12 @@ type Node interface {
 	GetID() hash.Peer
 	AddInternalTxn(inter.InternalTransaction) (hash.Transaction, error)
 	GetInternalTxn(hash.Transaction) (*inter.InternalTransaction, *inter.Event)
+	EmissionStats() map[string]uint64
 }
 
 // Consensus is a set of consensus handlers.
 type Consensus interface {
 	StakeOf(peer hash.Peer) uint64
 	GetEventBlock(hash.Event) *inter.Block
+	GetReceipt(hash.Transaction) *inter.Receipt
 }
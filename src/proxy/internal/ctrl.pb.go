@@ -247,6 +247,7 @@ type TransactionResponse struct {
 	Txn                  *wire.InternalTransaction `protobuf:"bytes,1,opt,name=txn,proto3" json:"txn,omitempty"`
 	Event                *wire.Event               `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 	Block                *wire.Block               `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Receipt              *wire.Receipt             `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -298,6 +299,13 @@ func (m *TransactionResponse) GetBlock() *wire.Block {
 	return nil
 }
 
+func (m *TransactionResponse) GetReceipt() *wire.Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
 type LogLevel struct {
 	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -337,6 +345,45 @@ func (m *LogLevel) GetLevel() string {
 	return ""
 }
 
+type EmissionStats struct {
+	Counts               map[string]uint64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EmissionStats) Reset()         { *m = EmissionStats{} }
+func (m *EmissionStats) String() string { return proto.CompactTextString(m) }
+func (*EmissionStats) ProtoMessage()    {}
+func (*EmissionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{7}
+}
+
+func (m *EmissionStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmissionStats.Unmarshal(m, b)
+}
+func (m *EmissionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmissionStats.Marshal(b, m, deterministic)
+}
+func (m *EmissionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionStats.Merge(m, src)
+}
+func (m *EmissionStats) XXX_Size() int {
+	return xxx_messageInfo_EmissionStats.Size(m)
+}
+func (m *EmissionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionStats proto.InternalMessageInfo
+
+func (m *EmissionStats) GetCounts() map[string]uint64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ID)(nil), "internal.ID")
 	proto.RegisterType((*Balance)(nil), "internal.Balance")
@@ -345,42 +392,50 @@ func init() {
 	proto.RegisterType((*TransactionRequest)(nil), "internal.TransactionRequest")
 	proto.RegisterType((*TransactionResponse)(nil), "internal.TransactionResponse")
 	proto.RegisterType((*LogLevel)(nil), "internal.LogLevel")
+	proto.RegisterType((*EmissionStats)(nil), "internal.EmissionStats")
+	proto.RegisterMapType((map[string]uint64)(nil), "internal.EmissionStats.CountsEntry")
 }
 
 func init() { proto.RegisterFile("internal/ctrl.proto", fileDescriptor_af4c68a24d38d4c7) }
 
 var fileDescriptor_af4c68a24d38d4c7 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xdb, 0x8e, 0xd3, 0x30,
-	0x10, 0x86, 0x7b, 0xda, 0x6e, 0x99, 0x22, 0xb1, 0x78, 0x51, 0xd5, 0x0d, 0x20, 0x75, 0x23, 0x84,
-	0x2a, 0xc1, 0x26, 0xa8, 0x5c, 0x72, 0x45, 0xd5, 0x05, 0x45, 0xac, 0x40, 0x4a, 0xfb, 0x02, 0xae,
-	0x3b, 0x49, 0xa3, 0x75, 0xed, 0xe2, 0x38, 0xa5, 0x5c, 0x71, 0xcb, 0xfb, 0xf0, 0x82, 0xc8, 0x76,
-	0xd2, 0xc3, 0x1e, 0x6e, 0xa2, 0xcc, 0xfc, 0x9f, 0x7e, 0xcf, 0xfc, 0x36, 0x9c, 0x67, 0x42, 0xa3,
-	0x12, 0x94, 0x87, 0x4c, 0x2b, 0x1e, 0xac, 0x95, 0xd4, 0x92, 0x74, 0xaa, 0xa6, 0xf7, 0x32, 0x95,
-	0x32, 0xe5, 0x18, 0xda, 0xfe, 0xbc, 0x48, 0x42, 0x5c, 0xad, 0xf5, 0x6f, 0x87, 0x79, 0xe3, 0x34,
-	0xd3, 0xcb, 0x62, 0x1e, 0x30, 0xb9, 0x0a, 0xbf, 0x50, 0xa1, 0xe5, 0xea, 0x2a, 0x91, 0x85, 0x58,
-	0x50, 0x9d, 0x49, 0x11, 0xa6, 0xf2, 0x8a, 0x53, 0xb6, 0xc4, 0x3c, 0xcb, 0xc3, 0x5c, 0xb1, 0xd0,
-	0x7a, 0x86, 0xbf, 0x32, 0x85, 0xf6, 0xe3, 0x3c, 0xfc, 0x1e, 0x34, 0xa2, 0x09, 0x39, 0x83, 0xe6,
-	0x12, 0xb7, 0xfd, 0xfa, 0xa0, 0x3e, 0x7c, 0x12, 0x9b, 0x5f, 0xff, 0x12, 0x4e, 0xc7, 0x94, 0x53,
-	0xc1, 0x90, 0xf4, 0xa0, 0x4d, 0x57, 0xb2, 0x10, 0xda, 0xea, 0xad, 0xb8, 0xac, 0xfc, 0x3f, 0xf0,
-	0x6c, 0xa6, 0xa8, 0xc8, 0x13, 0x54, 0x31, 0xfe, 0x2c, 0x30, 0xd7, 0xe4, 0x05, 0x9c, 0x08, 0x29,
-	0x18, 0x96, 0xa4, 0x2b, 0xc8, 0x10, 0x3a, 0x0a, 0x19, 0x66, 0x1b, 0x54, 0xfd, 0xc6, 0xa0, 0x3e,
-	0xec, 0x8e, 0x9e, 0x06, 0xd5, 0x86, 0x41, 0x34, 0x89, 0x77, 0xea, 0xc1, 0x51, 0xcd, 0xc3, 0xa3,
-	0x8c, 0x6f, 0x21, 0x74, 0xc6, 0xfb, 0x2d, 0xe7, 0x6b, 0x0b, 0xff, 0x0d, 0x9c, 0xed, 0x07, 0xc8,
-	0xd7, 0x52, 0xe4, 0xf8, 0xc0, 0x26, 0x6f, 0x81, 0x58, 0x8a, 0x32, 0x13, 0x4b, 0x35, 0xe9, 0x7d,
-	0xee, 0x6f, 0x1d, 0xce, 0x8f, 0xc0, 0xd2, 0xf1, 0x1d, 0x34, 0xf5, 0x56, 0x58, 0xb2, 0x3b, 0xba,
-	0x08, 0x6c, 0x76, 0x51, 0x39, 0xfd, 0x21, 0x6f, 0x28, 0x72, 0x09, 0x27, 0xb8, 0x41, 0xa1, 0xcb,
-	0x3d, 0xbb, 0x0e, 0xbf, 0x36, 0xad, 0xd8, 0x29, 0x06, 0x99, 0x73, 0xc9, 0x6e, 0xed, 0x8a, 0x3b,
-	0x64, 0x6c, 0x5a, 0xb1, 0x53, 0xfc, 0x01, 0x74, 0x6e, 0x64, 0x7a, 0x83, 0x1b, 0xe4, 0x66, 0x75,
-	0x6e, 0x7e, 0xca, 0x51, 0x5d, 0x31, 0xfa, 0xd7, 0x80, 0xd6, 0x77, 0xb9, 0x40, 0xf2, 0x01, 0xda,
-	0x53, 0xe4, 0x49, 0x34, 0x21, 0xbd, 0xc0, 0xbd, 0x95, 0xa0, 0x7a, 0x2b, 0xc1, 0xb5, 0x79, 0x2b,
-	0xde, 0x51, 0xd6, 0x7e, 0x8d, 0xbc, 0x87, 0xd3, 0xa9, 0xa6, 0xb7, 0xf8, 0x23, 0x21, 0x47, 0x92,
-	0xf7, 0x7c, 0x5f, 0x95, 0x57, 0xef, 0xd7, 0xc8, 0x67, 0xe3, 0x2f, 0x16, 0x33, 0x49, 0x2e, 0xf6,
-	0xf2, 0x9d, 0x6b, 0xf7, 0xbc, 0x87, 0x24, 0x17, 0x9f, 0x5f, 0x23, 0xdf, 0x00, 0xbe, 0xa2, 0x9e,
-	0x6d, 0x45, 0x24, 0x12, 0x49, 0x5e, 0xdd, 0x61, 0x8f, 0xae, 0xc5, 0x7b, 0xfd, 0x88, 0xba, 0x33,
-	0xfb, 0x04, 0xdd, 0x29, 0xea, 0x5d, 0x3a, 0x64, 0xcf, 0x57, 0x3d, 0xef, 0x91, 0x20, 0xfc, 0xda,
-	0xbc, 0x6d, 0x3b, 0x1f, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x29, 0xd4, 0x49, 0xd5, 0x75, 0x03,
-	0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x51, 0x8f, 0xd2, 0x40,
+	0x10, 0xc7, 0x29, 0x70, 0xdc, 0x39, 0xf5, 0x22, 0xee, 0x19, 0xe4, 0xaa, 0x26, 0x5c, 0x35, 0x4a,
+	0xa2, 0xd7, 0x1a, 0x7c, 0x51, 0xef, 0x49, 0x04, 0x2f, 0xc4, 0x8b, 0x26, 0x85, 0x2f, 0xb0, 0x94,
+	0x29, 0x34, 0x94, 0x5d, 0x6c, 0xb7, 0x08, 0x4f, 0x3e, 0xfa, 0x79, 0xfc, 0x7a, 0x3e, 0x99, 0xdd,
+	0x6d, 0x81, 0x9e, 0xc7, 0x0b, 0xe9, 0xcc, 0xff, 0xc7, 0xec, 0xcc, 0x7f, 0x06, 0xce, 0x42, 0x26,
+	0x30, 0x66, 0x34, 0x72, 0x7d, 0x11, 0x47, 0xce, 0x32, 0xe6, 0x82, 0x93, 0x93, 0x3c, 0x69, 0x3d,
+	0x99, 0x72, 0x3e, 0x8d, 0xd0, 0x55, 0xf9, 0x71, 0x1a, 0xb8, 0xb8, 0x58, 0x8a, 0x8d, 0xc6, 0xac,
+	0xee, 0x34, 0x14, 0xb3, 0x74, 0xec, 0xf8, 0x7c, 0xe1, 0x7e, 0xa1, 0x4c, 0xf0, 0xc5, 0x65, 0xc0,
+	0x53, 0x36, 0xa1, 0x22, 0xe4, 0xcc, 0x9d, 0xf2, 0xcb, 0x88, 0xfa, 0x33, 0x4c, 0xc2, 0xc4, 0x4d,
+	0x62, 0xdf, 0x55, 0x35, 0xdd, 0x9f, 0x61, 0x8c, 0xea, 0x47, 0xd7, 0xb0, 0x1b, 0x50, 0x1e, 0xf4,
+	0x48, 0x1d, 0x2a, 0x33, 0x5c, 0x37, 0x8d, 0x96, 0xd1, 0xbe, 0xe7, 0xc9, 0x4f, 0xfb, 0x02, 0x8e,
+	0xbb, 0x34, 0xa2, 0xcc, 0x47, 0xd2, 0x80, 0x1a, 0x5d, 0xf0, 0x94, 0x09, 0xa5, 0x57, 0xbd, 0x2c,
+	0xb2, 0x7f, 0xc1, 0x83, 0x51, 0x4c, 0x59, 0x12, 0x60, 0xec, 0xe1, 0x8f, 0x14, 0x13, 0x41, 0x1e,
+	0xc1, 0x11, 0xe3, 0xcc, 0xc7, 0x8c, 0xd4, 0x01, 0x69, 0xc3, 0x49, 0x8c, 0x3e, 0x86, 0x2b, 0x8c,
+	0x9b, 0xe5, 0x96, 0xd1, 0x36, 0x3b, 0xf7, 0x9d, 0x7c, 0x42, 0x67, 0xd0, 0xf3, 0xb6, 0xea, 0xde,
+	0x53, 0x95, 0xfd, 0xa7, 0x64, 0xdd, 0x94, 0x89, 0x30, 0x6a, 0x56, 0x75, 0x5d, 0x15, 0xd8, 0x2f,
+	0xa0, 0xbe, 0x6b, 0x20, 0x59, 0x72, 0x96, 0xe0, 0x1d, 0x93, 0xbc, 0x04, 0xa2, 0x28, 0xea, 0x4b,
+	0x5b, 0xf2, 0x4e, 0xff, 0xe7, 0xfe, 0x18, 0x70, 0x56, 0x00, 0xb3, 0x8a, 0xaf, 0xa1, 0x22, 0xd6,
+	0x4c, 0x91, 0x66, 0xe7, 0xdc, 0x51, 0xde, 0x0d, 0xb2, 0xee, 0xf7, 0x79, 0x49, 0x91, 0x0b, 0x38,
+	0xc2, 0x15, 0x32, 0x91, 0xcd, 0x69, 0x6a, 0xbc, 0x2f, 0x53, 0x9e, 0x56, 0x24, 0x32, 0x8e, 0xb8,
+	0x3f, 0x6f, 0x56, 0xf6, 0x91, 0xae, 0x4c, 0x79, 0x5a, 0x21, 0xaf, 0xe0, 0x58, 0x59, 0xb2, 0x14,
+	0x6a, 0x60, 0xb3, 0x73, 0xaa, 0x21, 0x4f, 0x27, 0xbd, 0x5c, 0xb5, 0x5b, 0x70, 0x72, 0xc3, 0xa7,
+	0x37, 0xb8, 0xc2, 0x48, 0x7a, 0x14, 0xc9, 0x8f, 0x6c, 0x26, 0x1d, 0xd8, 0xbf, 0x0d, 0x38, 0xed,
+	0x2f, 0xc2, 0x24, 0x09, 0x39, 0x1b, 0x0a, 0x2a, 0x12, 0x72, 0x05, 0x35, 0x5f, 0x9a, 0x9a, 0x34,
+	0x8d, 0x56, 0xa5, 0x6d, 0x76, 0x9e, 0xef, 0x76, 0x51, 0x00, 0x9d, 0xcf, 0x8a, 0xea, 0x33, 0x11,
+	0x6f, 0xbc, 0xec, 0x2f, 0xd6, 0x07, 0x30, 0xf7, 0xd2, 0xd2, 0xc5, 0x39, 0x6e, 0x72, 0x17, 0xe7,
+	0xb8, 0x91, 0x5d, 0xac, 0x68, 0x94, 0xa2, 0x32, 0xa0, 0xea, 0xe9, 0xe0, 0x63, 0xf9, 0xbd, 0xd1,
+	0xf9, 0x5b, 0x86, 0xea, 0x37, 0x3e, 0x41, 0xf2, 0x16, 0x6a, 0x43, 0x8c, 0x82, 0x41, 0x8f, 0x34,
+	0x1c, 0x7d, 0xde, 0x4e, 0x7e, 0xde, 0x4e, 0x5f, 0x9e, 0xb7, 0x55, 0x38, 0x0f, 0xbb, 0x44, 0xde,
+	0xc0, 0xf1, 0x50, 0xd0, 0x39, 0x7e, 0x0f, 0x48, 0x41, 0xb2, 0x1e, 0xee, 0xa2, 0xec, 0x5a, 0xed,
+	0x12, 0xf9, 0x24, 0xeb, 0xb3, 0xc9, 0x88, 0x93, 0xf3, 0x9d, 0x7c, 0xeb, 0x52, 0x2d, 0xeb, 0x2e,
+	0x49, 0x6f, 0xdc, 0x2e, 0x91, 0xaf, 0x00, 0xd7, 0x28, 0x46, 0x6b, 0x36, 0x60, 0x01, 0x27, 0x4f,
+	0x6f, 0xb1, 0x85, 0x4b, 0xb2, 0x9e, 0x1d, 0x50, 0xb7, 0xc5, 0xae, 0xc0, 0x1c, 0xa2, 0xd8, 0xee,
+	0x89, 0xec, 0xf8, 0x3c, 0x67, 0x1d, 0x30, 0xc2, 0x2e, 0x91, 0x3e, 0xd4, 0xaf, 0x51, 0x14, 0x37,
+	0x78, 0xc8, 0xb6, 0xc7, 0x07, 0x36, 0x69, 0x97, 0xc6, 0x35, 0x85, 0xbe, 0xfb, 0x37, 0x00, 0xcd,
+	0x1f, 0xb2, 0x1e, 0x6f, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -400,6 +455,7 @@ type NodeClient interface {
 	SendTo(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
 	GetTxnInfo(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetEmissionStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*EmissionStats, error)
 }
 
 type nodeClient struct {
@@ -455,6 +511,15 @@ func (c *nodeClient) SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc
 	return out, nil
 }
 
+func (c *nodeClient) GetEmissionStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*EmissionStats, error) {
+	out := new(EmissionStats)
+	err := c.cc.Invoke(ctx, "/internal.Node/GetEmissionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 type NodeServer interface {
 	SelfID(context.Context, *empty.Empty) (*ID, error)
@@ -462,6 +527,7 @@ type NodeServer interface {
 	SendTo(context.Context, *TransferRequest) (*TransferResponse, error)
 	GetTxnInfo(context.Context, *TransactionRequest) (*TransactionResponse, error)
 	SetLogLevel(context.Context, *LogLevel) (*empty.Empty, error)
+	GetEmissionStats(context.Context, *empty.Empty) (*EmissionStats, error)
 }
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
@@ -558,6 +624,24 @@ func _Node_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_GetEmissionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetEmissionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/internal.Node/GetEmissionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetEmissionStats(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Node_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "internal.Node",
 	HandlerType: (*NodeServer)(nil),
@@ -582,6 +666,10 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetLogLevel",
 			Handler:    _Node_SetLogLevel_Handler,
 		},
+		{
+			MethodName: "GetEmissionStats",
+			Handler:    _Node_GetEmissionStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/ctrl.proto",
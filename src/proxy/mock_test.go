@@ -147,6 +147,20 @@ func (mr *MockNodeMockRecorder) GetInternalTxn(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalTxn", reflect.TypeOf((*MockNode)(nil).GetInternalTxn), arg0)
 }
 
+// EmissionStats mocks base method
+func (m *MockNode) EmissionStats() map[string]uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmissionStats")
+	ret0, _ := ret[0].(map[string]uint64)
+	return ret0
+}
+
+// EmissionStats indicates an expected call of EmissionStats
+func (mr *MockNodeMockRecorder) EmissionStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmissionStats", reflect.TypeOf((*MockNode)(nil).EmissionStats))
+}
+
 // MockConsensus is a mock of Consensus interface
 type MockConsensus struct {
 	ctrl     *gomock.Controller
@@ -197,3 +211,17 @@ func (mr *MockConsensusMockRecorder) GetEventBlock(arg0 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBlock", reflect.TypeOf((*MockConsensus)(nil).GetEventBlock), arg0)
 }
+
+// GetReceipt mocks base method
+func (m *MockConsensus) GetReceipt(arg0 hash.Transaction) *inter.Receipt {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceipt", arg0)
+	ret0, _ := ret[0].(*inter.Receipt)
+	return ret0
+}
+
+// GetReceipt indicates an expected call of GetReceipt
+func (mr *MockConsensusMockRecorder) GetReceipt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipt", reflect.TypeOf((*MockConsensus)(nil).GetReceipt), arg0)
+}
@@ -58,10 +58,12 @@ type NodeProxy interface {
 	StakeOf(hash.Peer) (uint64, error)
 	// SendTo makes stake transfer transaction.
 	SendTo(receiver hash.Peer, index, amount, until uint64) (hash.Transaction, error)
-	// GetTxnInfo returns information about transaction.
-	GetTxnInfo(hash.Transaction) (*inter.InternalTransaction, *inter.Event, *inter.Block, error)
+	// GetTxnInfo returns information about transaction and its receipt if txn is ordered.
+	GetTxnInfo(hash.Transaction) (*inter.InternalTransaction, *inter.Event, *inter.Block, *inter.Receipt, error)
 	// SetLogLevel sets logger log level.
 	SetLogLevel(string) error
+	// GetEmissionStats returns counts of emitted events by reasons.
+	GetEmissionStats() (map[string]uint64, error)
 	// Close stops proxy.
 	Close()
 }
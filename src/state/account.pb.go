@@ -66,6 +66,8 @@ type Account struct {
 	DelegatingFrom       map[string]*Borrow `protobuf:"bytes,4,rep,name=DelegatingFrom,proto3" json:"DelegatingFrom,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	DelegatedTo          uint64             `protobuf:"varint,5,opt,name=DelegatedTo,proto3" json:"DelegatedTo,omitempty"`
 	DelegatingTo         map[string]*Borrow `protobuf:"bytes,6,rep,name=DelegatingTo,proto3" json:"DelegatingTo,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IsValidator          bool               `protobuf:"varint,7,opt,name=IsValidator,proto3" json:"IsValidator,omitempty"`
+	Nonce                uint64             `protobuf:"varint,8,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -138,6 +140,20 @@ func (m *Account) GetDelegatingTo() map[string]*Borrow {
 	return nil
 }
 
+func (m *Account) GetIsValidator() bool {
+	if m != nil {
+		return m.IsValidator
+	}
+	return false
+}
+
+func (m *Account) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Borrow)(nil), "state.Borrow")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "state.Borrow.RecsEntry")
@@ -149,23 +165,25 @@ func init() {
 func init() { proto.RegisterFile("account.proto", fileDescriptor_8e28828dcb8d24f0) }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4d, 0x4b, 0xc3, 0x40,
-	0x10, 0x25, 0xcd, 0x47, 0xe9, 0xa4, 0x11, 0x5d, 0x05, 0x97, 0x9c, 0x42, 0xf4, 0x50, 0x10, 0x72,
-	0xa8, 0x07, 0xc5, 0x9b, 0xa5, 0x7a, 0xf0, 0x20, 0xb2, 0xe4, 0x0f, 0xac, 0x71, 0x29, 0x62, 0xdc,
-	0x91, 0xed, 0xd6, 0xd2, 0x9f, 0xe6, 0xbf, 0x93, 0xcc, 0xa6, 0x35, 0xf1, 0xe3, 0xe4, 0x2d, 0xf3,
-	0xde, 0xbc, 0x79, 0x33, 0x2f, 0x0b, 0x89, 0xac, 0x2a, 0x5c, 0x69, 0x5b, 0xbc, 0x19, 0xb4, 0xc8,
-	0xc2, 0xa5, 0x95, 0x56, 0xe5, 0x1a, 0xa2, 0x19, 0x1a, 0x83, 0x6b, 0x76, 0x06, 0x81, 0x50, 0xd5,
-	0x92, 0x7b, 0x99, 0x3f, 0x89, 0xa7, 0xc7, 0x05, 0xf1, 0x85, 0x23, 0x8b, 0x86, 0xb9, 0xd1, 0xd6,
-	0x6c, 0x04, 0x35, 0xa5, 0x17, 0x30, 0xda, 0x41, 0x6c, 0x1f, 0xfc, 0x17, 0xb5, 0xe1, 0x5e, 0xe6,
-	0x4d, 0x02, 0xd1, 0x7c, 0xb2, 0x23, 0x08, 0xdf, 0x65, 0xbd, 0x52, 0x7c, 0x40, 0x98, 0x2b, 0xae,
-	0x06, 0x97, 0x5e, 0xfe, 0xe1, 0xc3, 0xf0, 0xda, 0x2d, 0xc2, 0x38, 0x0c, 0x67, 0xb2, 0x96, 0xba,
-	0x52, 0xad, 0x76, 0x5b, 0x36, 0x8c, 0x90, 0x6b, 0x81, 0x68, 0x69, 0xc2, 0x58, 0x6c, 0x4b, 0x76,
-	0x0a, 0xc9, 0x5c, 0xd5, 0x6a, 0x21, 0xad, 0x7a, 0xba, 0x35, 0xf8, 0xca, 0x7d, 0x52, 0xf6, 0x41,
-	0x76, 0x07, 0x7b, 0x2d, 0xf0, 0xac, 0x17, 0xd4, 0x16, 0xd0, 0x55, 0x79, 0x7b, 0x55, 0xbb, 0x41,
-	0xd1, 0x6f, 0x72, 0x07, 0x7e, 0x53, 0xb2, 0x0c, 0xe2, 0xdd, 0xf0, 0x12, 0x79, 0x48, 0x7e, 0x5d,
-	0x88, 0xcd, 0x61, 0xfc, 0xa5, 0x29, 0x91, 0x47, 0xe4, 0x95, 0xfd, 0xe9, 0x55, 0xa2, 0x73, 0xea,
-	0xa9, 0xd2, 0x07, 0x38, 0xfc, 0x65, 0x9d, 0x6e, 0xb8, 0x23, 0x17, 0xee, 0x49, 0x37, 0xdc, 0x78,
-	0x9a, 0xf4, 0xfe, 0x54, 0x27, 0xeb, 0xf4, 0x1e, 0x0e, 0x7e, 0x98, 0xfe, 0x63, 0xde, 0x63, 0x44,
-	0x2f, 0xe7, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xf1, 0x3b, 0x5d, 0x4a, 0x02, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4f, 0x4b, 0xfb, 0x40,
+	0x10, 0x25, 0x4d, 0x9b, 0xb4, 0x93, 0xe6, 0xc7, 0xcf, 0x55, 0x70, 0xc9, 0x29, 0x44, 0x0f, 0x01,
+	0x21, 0x87, 0x7a, 0x50, 0xbc, 0x59, 0xaa, 0xa0, 0x87, 0x22, 0x4b, 0xf0, 0xbe, 0xa6, 0x4b, 0x29,
+	0xc6, 0x8c, 0x6c, 0xb6, 0x96, 0x7e, 0x6c, 0xbf, 0x81, 0xec, 0x6e, 0x5a, 0x13, 0xff, 0x9c, 0xbc,
+	0xed, 0xbc, 0x99, 0x37, 0x6f, 0x66, 0xde, 0x42, 0xc8, 0x8b, 0x02, 0xd7, 0x95, 0xca, 0x5e, 0x25,
+	0x2a, 0x24, 0x83, 0x5a, 0x71, 0x25, 0x92, 0x0a, 0xbc, 0x29, 0x4a, 0x89, 0x1b, 0x72, 0x06, 0x7d,
+	0x26, 0x8a, 0x9a, 0x3a, 0xb1, 0x9b, 0x06, 0x93, 0xe3, 0xcc, 0xe4, 0x33, 0x9b, 0xcc, 0x74, 0xe6,
+	0xa6, 0x52, 0x72, 0xcb, 0x4c, 0x51, 0x74, 0x01, 0xa3, 0x3d, 0x44, 0xfe, 0x83, 0xfb, 0x2c, 0xb6,
+	0xd4, 0x89, 0x9d, 0xb4, 0xcf, 0xf4, 0x93, 0x1c, 0xc1, 0xe0, 0x8d, 0x97, 0x6b, 0x41, 0x7b, 0x06,
+	0xb3, 0xc1, 0x55, 0xef, 0xd2, 0x49, 0xde, 0x5d, 0xf0, 0xaf, 0xed, 0x20, 0x84, 0x82, 0x3f, 0xe5,
+	0x25, 0xaf, 0x0a, 0xd1, 0x70, 0x77, 0xa1, 0xce, 0x30, 0xbe, 0x61, 0x88, 0xca, 0x74, 0x18, 0xb3,
+	0x5d, 0x48, 0x4e, 0x21, 0x9c, 0x89, 0x52, 0x2c, 0xb9, 0x12, 0x8b, 0x5b, 0x89, 0x2f, 0xd4, 0x35,
+	0xcc, 0x2e, 0x48, 0xee, 0xe1, 0x5f, 0x03, 0xac, 0xaa, 0xa5, 0x29, 0xeb, 0x9b, 0xad, 0x92, 0x66,
+	0xab, 0x66, 0x82, 0xac, 0x5b, 0x64, 0x17, 0xfc, 0xc2, 0x24, 0x31, 0x04, 0xfb, 0xe6, 0x39, 0xd2,
+	0x81, 0xd1, 0x6b, 0x43, 0x64, 0x06, 0xe3, 0x4f, 0x4e, 0x8e, 0xd4, 0x33, 0x5a, 0xf1, 0xaf, 0x5a,
+	0x39, 0x5a, 0xa5, 0x0e, 0x4b, 0xeb, 0xdc, 0xd5, 0x8f, 0xbc, 0x5c, 0x2d, 0xb8, 0x42, 0x49, 0xfd,
+	0xd8, 0x49, 0x87, 0xac, 0x0d, 0xe9, 0xab, 0xce, 0x51, 0x5f, 0x6b, 0x68, 0xaf, 0x6a, 0x82, 0xe8,
+	0x01, 0x0e, 0x7f, 0x58, 0xa3, 0x6d, 0xca, 0xc8, 0x9a, 0x72, 0xd2, 0x36, 0x25, 0x98, 0x84, 0x1d,
+	0x87, 0x5b, 0x1e, 0x45, 0x73, 0x38, 0xf8, 0x36, 0xec, 0x1f, 0xfa, 0x3d, 0x79, 0xe6, 0xc7, 0x9d,
+	0x7f, 0x0c, 0x00, 0xc4, 0x9e, 0xba, 0xb2, 0x82, 0x02, 0x00, 0x00,
 }
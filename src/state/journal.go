@@ -85,6 +85,14 @@ type (
 		account *hash.Peer
 		prev    uint64
 	}
+	validatorChange struct {
+		account *hash.Peer
+		prev    bool
+	}
+	nonceChange struct {
+		account *hash.Peer
+		prev    uint64
+	}
 	storageChange struct {
 		account       *hash.Peer
 		key, prevalue hash.Hash
@@ -153,6 +161,22 @@ func (ch balanceChange) dirtied() *hash.Peer {
 	return ch.account
 }
 
+func (ch validatorChange) revert(s *DB) {
+	s.getStateObject(*ch.account).data.IsValidator = ch.prev
+}
+
+func (ch validatorChange) dirtied() *hash.Peer {
+	return ch.account
+}
+
+func (ch nonceChange) revert(s *DB) {
+	s.getStateObject(*ch.account).data.Nonce = ch.prev
+}
+
+func (ch nonceChange) dirtied() *hash.Peer {
+	return ch.account
+}
+
 func (ch storageChange) revert(s *DB) {
 	s.getStateObject(*ch.account).setState(ch.key, ch.prevalue)
 }
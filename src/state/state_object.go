@@ -71,7 +71,7 @@ type stateObject struct {
 
 // empty returns whether the account is considered empty.
 func (s *stateObject) empty() bool {
-	return s.data.Balance == 0
+	return s.data.Balance == 0 && s.data.Nonce == 0
 }
 
 // newObject creates a state object.
@@ -240,6 +240,24 @@ func (s *stateObject) SetBalance(amount uint64) {
 	s.data.Balance = amount
 }
 
+// SetValidator sets validator registration flag.
+func (s *stateObject) SetValidator(is bool) {
+	s.db.journal.append(validatorChange{
+		account: &s.address,
+		prev:    s.data.IsValidator,
+	})
+	s.data.IsValidator = is
+}
+
+// SetNonce sets the next expected txn index.
+func (s *stateObject) SetNonce(nonce uint64) {
+	s.db.journal.append(nonceChange{
+		account: &s.address,
+		prev:    s.data.Nonce,
+	})
+	s.data.Nonce = nonce
+}
+
 // DelegateTo writes data about delegation.
 func (s *stateObject) DelegateTo(addr hash.Peer, amount int64, until uint64) {
 	if addr == s.address || amount == 0 || until < 1 {
@@ -405,6 +423,16 @@ func (s *stateObject) VoteBalance() uint64 {
 	return s.data.Balance + s.data.DelegatedFrom - s.data.DelegatedTo
 }
 
+// IsValidator returns true if account is registered as validator.
+func (s *stateObject) IsValidator() bool {
+	return s.data.IsValidator
+}
+
+// Nonce returns the next expected txn index.
+func (s *stateObject) Nonce() uint64 {
+	return s.data.Nonce
+}
+
 // Data returns data.
 func (s *stateObject) Data() *Account {
 	return &s.data
@@ -153,6 +153,24 @@ func (s *DB) VoteBalance(addr hash.Peer) uint64 {
 	return 0
 }
 
+// IsValidator returns true if the given address is registered as validator.
+func (s *DB) IsValidator(addr hash.Peer) bool {
+	stateObject := s.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.IsValidator()
+	}
+	return false
+}
+
+// GetNonce returns the next expected txn index of the given address or 0 if object not found.
+func (s *DB) GetNonce(addr hash.Peer) uint64 {
+	stateObject := s.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.Nonce()
+	}
+	return 0
+}
+
 // GetState retrieves a value from the given account's storage trie.
 func (s *DB) GetState(addr hash.Peer, h hash.Hash) hash.Hash {
 	stateObject := s.getStateObject(addr)
@@ -227,6 +245,42 @@ func (s *DB) SetBalance(addr hash.Peer, amount uint64) {
 	stateObject.SetBalance(amount)
 }
 
+// AddBalance adds amount to stateObject's balance by address.
+func (s *DB) AddBalance(addr hash.Peer, amount uint64) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject == nil {
+		panic("stateObject is nil")
+	}
+	stateObject.AddBalance(amount)
+}
+
+// SubBalance subtracts amount from stateObject's balance by address.
+func (s *DB) SubBalance(addr hash.Peer, amount uint64) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject == nil {
+		panic("stateObject is nil")
+	}
+	stateObject.SubBalance(amount)
+}
+
+// SetValidator registers or unregisters address as validator.
+func (s *DB) SetValidator(addr hash.Peer, is bool) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject == nil {
+		panic("stateObject is nil")
+	}
+	stateObject.SetValidator(is)
+}
+
+// SetNonce sets the next expected txn index of the given address.
+func (s *DB) SetNonce(addr hash.Peer, nonce uint64) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject == nil {
+		panic("stateObject is nil")
+	}
+	stateObject.SetNonce(nonce)
+}
+
 // Transfer moves amount.
 func (s *DB) Transfer(from, to hash.Peer, amount uint64) {
 	f := s.GetOrNewStateObject(from)
@@ -193,3 +193,66 @@ func TestDelegationState(t *testing.T) {
 	check(FROM, root, aa[1], 15, __, 00)
 	check(FROM, root, aa[2], 00, 25, __)
 }
+
+func TestValidatorState(t *testing.T) {
+	assertar := assert.New(t)
+
+	addr := hash.FakePeer()
+	store := NewDatabase(kvdb.NewMemDatabase())
+
+	db, err := New(hash.Hash{}, store)
+	if !assertar.NoError(err) {
+		return
+	}
+	assertar.False(db.IsValidator(addr))
+
+	db.SetBalance(addr, 1)
+	db.SetValidator(addr, true)
+	assertar.True(db.IsValidator(addr))
+
+	root, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+
+	db, err = New(root, store)
+	if !assertar.NoError(err) {
+		return
+	}
+	assertar.True(db.IsValidator(addr))
+
+	db.SetValidator(addr, false)
+	assertar.False(db.IsValidator(addr))
+}
+
+func TestNonceState(t *testing.T) {
+	assertar := assert.New(t)
+
+	addr := hash.FakePeer()
+	store := NewDatabase(kvdb.NewMemDatabase())
+
+	db, err := New(hash.Hash{}, store)
+	if !assertar.NoError(err) {
+		return
+	}
+	assertar.Equal(uint64(0), db.GetNonce(addr))
+
+	db.SetNonce(addr, 1)
+	assertar.Equal(uint64(1), db.GetNonce(addr))
+
+	root, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+
+	db, err = New(root, store)
+	if !assertar.NoError(err) {
+		return
+	}
+	assertar.Equal(uint64(1), db.GetNonce(addr))
+
+	snapshot := db.Snapshot()
+	db.SetNonce(addr, 2)
+	db.RevertToSnapshot(snapshot)
+	assertar.Equal(uint64(1), db.GetNonce(addr))
+}
@@ -0,0 +1,25 @@
+package state
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
+)
+
+// NewStateSync creates a new state trie download scheduler.
+func NewStateSync(root hash.Hash, database trie.DatabaseReader) *trie.Sync {
+	var syncer *trie.Sync
+	callback := func(leaf []byte, parent hash.Hash) error {
+		var account Account
+		if err := proto.Unmarshal(leaf, &account); err != nil {
+			return err
+		}
+		if r := account.Root(); r != (hash.Hash{}) && r != emptyState {
+			syncer.AddSubTrie(r, 64, parent, nil)
+		}
+		return nil
+	}
+	syncer = trie.NewSync(root, database, callback)
+	return syncer
+}
@@ -0,0 +1,63 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/kvdb"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
+)
+
+func TestStateSync(t *testing.T) {
+	assertar := assert.New(t)
+
+	// source state
+	src := NewDatabase(kvdb.NewMemDatabase())
+	db, err := New(hash.Hash{}, src)
+	if !assertar.NoError(err) {
+		return
+	}
+	balances := make(map[hash.Peer]uint64)
+	for i := uint64(1); i <= 100; i++ {
+		addr := hash.FakePeer()
+		balances[addr] = i
+		db.SetBalance(addr, i)
+		db.SetNonce(addr, i)
+	}
+	root, err := db.Commit(true)
+	if !assertar.NoError(err) {
+		return
+	}
+
+	// download
+	mem := kvdb.NewMemDatabase()
+	sched := NewStateSync(root, mem)
+	for queue := sched.Missing(10); len(queue) > 0; queue = sched.Missing(10) {
+		results := make([]trie.SyncResult, len(queue))
+		for i, h := range queue {
+			data, err := src.TrieDB().Node(h)
+			if !assertar.NoError(err) {
+				return
+			}
+			results[i] = trie.SyncResult{Hash: h, Data: data}
+		}
+		if _, _, err := sched.Process(results); !assertar.NoError(err) {
+			return
+		}
+		if _, err := sched.Commit(mem); !assertar.NoError(err) {
+			return
+		}
+	}
+
+	// check
+	dst, err := New(root, NewDatabase(mem))
+	if !assertar.NoError(err) {
+		return
+	}
+	for addr, balance := range balances {
+		assertar.Equal(balance, dst.FreeBalance(addr))
+		assertar.Equal(balance, dst.GetNonce(addr))
+	}
+}